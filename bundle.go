@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/discord-gophers/goapi-gen/codegen"
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v3"
+)
+
+func bundle(c *cli.Context, cfg *config) error {
+	file := c.Args().First()
+	if file == "" {
+		file = cfg.Spec
+	}
+
+	swagger, err := loadSpec(cfg, file)
+	if err != nil {
+		return err
+	}
+	if err := codegen.BundleSpec(swagger); err != nil {
+		return fmt.Errorf("could not bundle spec: %v", err)
+	}
+
+	data, err := swagger.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("could not marshal spec: %v", err)
+	}
+
+	format := c.String(FormatKey)
+	if format == "" {
+		format = "yaml"
+		if filepath.Ext(cfg.Out) == ".json" {
+			format = "json"
+		}
+	}
+	switch format {
+	case "json":
+		var v interface{}
+		if err := json.Unmarshal(data, &v); err != nil {
+			return fmt.Errorf("could not marshal spec: %v", err)
+		}
+		if data, err = json.MarshalIndent(v, "", "  "); err != nil {
+			return fmt.Errorf("could not marshal spec: %v", err)
+		}
+		data = append(data, '\n')
+	case "yaml":
+		var v interface{}
+		if err := yaml.Unmarshal(data, &v); err != nil {
+			return fmt.Errorf("could not marshal spec: %v", err)
+		}
+		var buf bytes.Buffer
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+		if err := enc.Encode(v); err != nil {
+			return fmt.Errorf("could not marshal spec: %v", err)
+		}
+		data = buf.Bytes()
+	default:
+		return fmt.Errorf("unknown output format: %s", format)
+	}
+
+	if cfg.Out == "" {
+		if _, err := os.Stdout.Write(data); err != nil {
+			return fmt.Errorf("could not write spec: %v", err)
+		}
+		return nil
+	}
+	if _, err := writeIfChanged(cfg.Out, string(data)); err != nil {
+		return fmt.Errorf("could not write spec: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,362 @@
+package codegen
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/kenshaw/snaker"
+)
+
+// BundleSpec makes swagger self-contained, by moving every value it references
+// from other files into its components, and pointing the references at them.
+//
+// A value keeps the name it has in its own file, unless that name is taken in
+// swagger. It is then prefixed by the name of the file, or suffixed by a
+// number if that is not enough. swagger must have been loaded with its
+// external references resolved.
+func BundleSpec(swagger *openapi3.T) error {
+	b := bundler{
+		names:    make(map[string]map[string]bool),
+		local:    make(map[interface{}]string),
+		external: make(map[string][]interface{}),
+	}
+
+	// Components which reference another file take the referenced value in
+	// place. References to the same value from other components point at the
+	// first one.
+	for _, kind := range componentKinds {
+		refs := componentRefs(&swagger.Components, kind)
+		b.names[kind] = make(map[string]bool, len(refs))
+		for name := range refs {
+			b.names[kind][name] = true
+		}
+		for _, name := range sortedKeys(refs) {
+			if ref := refs[name]; ref.ref == "" {
+				b.local[ref.value] = name
+			}
+		}
+		for _, name := range sortedKeys(refs) {
+			ref := refs[name]
+			if ref.ref == "" || isLocalRef(ref.ref) {
+				continue
+			}
+			if ref.value == nil {
+				return fmt.Errorf("unresolved reference %s", ref.ref)
+			}
+			if existing, ok := b.lookup(ref); ok {
+				ref.set(componentRef(kind, existing))
+				continue
+			}
+			ref.set("")
+			b.local[ref.value] = name
+			b.external[ref.ref] = append(b.external[ref.ref], ref.value)
+		}
+	}
+
+	visited := make(map[interface{}]bool)
+	err := walkSwagger(swagger, func(w RefWrapper) (bool, error) {
+		ref, ok := bundleRefOf(w.SourceRef)
+		if !ok || ref.value == nil {
+			return ok, nil
+		}
+		if ref.ref != "" {
+			b.refs = append(b.refs, ref)
+		}
+		if visited[ref.value] {
+			return false, nil
+		}
+		visited[ref.value] = true
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return b.bundle(&swagger.Components)
+}
+
+// componentKinds are the keys of the components object, in the order in which
+// they are bundled.
+var componentKinds = []string{
+	"schemas",
+	"parameters",
+	"headers",
+	"requestBodies",
+	"responses",
+	"securitySchemes",
+	"examples",
+	"links",
+	"callbacks",
+}
+
+type bundler struct {
+	// names are the names taken in components, by kind.
+	names map[string]map[string]bool
+	// local maps the values in components to their name.
+	local map[interface{}]string
+	// external maps references with a file to the values in components they
+	// were resolved to. Each reference to a file without a JSON pointer is
+	// resolved to a new value, so these are compared by content.
+	external map[string][]interface{}
+	// refs are all references found in the spec.
+	refs []bundleRef
+}
+
+// bundleRef is a reference to a value, of one of the kinds of components.
+type bundleRef struct {
+	kind  string
+	ref   string
+	value interface{}
+	set   func(ref string)
+}
+
+func (b *bundler) bundle(components *openapi3.Components) error {
+	// Refs to the same value are grouped, and groups are named in order of
+	// their first ref, so names don't depend on the order of the walk.
+	// References with a file come first, since they name the value's file.
+	groups := make(map[interface{}][]bundleRef)
+	var values []interface{}
+	for _, ref := range b.refs {
+		if name, ok := b.lookup(ref); ok {
+			ref.set(componentRef(ref.kind, name))
+			continue
+		}
+		if _, ok := groups[ref.value]; !ok {
+			values = append(values, ref.value)
+		}
+		groups[ref.value] = append(groups[ref.value], ref)
+	}
+	for _, refs := range groups {
+		sort.Slice(refs, func(i, j int) bool {
+			return refLess(refs[i].ref, refs[j].ref)
+		})
+	}
+	sort.SliceStable(values, func(i, j int) bool {
+		return refLess(groups[values[i]][0].ref, groups[values[j]][0].ref)
+	})
+
+	for _, value := range values {
+		refs := groups[value]
+		first := refs[0]
+		if isLocalRef(first.ref) && !strings.HasPrefix(first.ref, "#/components/") {
+			return fmt.Errorf("can't bundle reference %s", first.ref)
+		}
+
+		name, ok := b.lookup(first)
+		if !ok {
+			name = b.name(first)
+			b.names[first.kind][name] = true
+			addComponent(components, first.kind, name, value)
+		}
+		b.local[value] = name
+		for _, ref := range refs {
+			if !isLocalRef(ref.ref) {
+				b.external[ref.ref] = append(b.external[ref.ref], value)
+			}
+			ref.set(componentRef(ref.kind, name))
+		}
+	}
+	return nil
+}
+
+// lookup returns the name of the value ref references in components.
+func (b *bundler) lookup(ref bundleRef) (string, bool) {
+	if name, ok := b.local[ref.value]; ok {
+		return name, true
+	}
+	for _, value := range b.external[ref.ref] {
+		if reflect.DeepEqual(value, ref.value) {
+			return b.local[value], true
+		}
+	}
+	return "", false
+}
+
+// name returns a free name in components for the value ref references.
+func (b *bundler) name(ref bundleRef) string {
+	file, pointer := splitRef(ref.ref)
+	name := path.Base(pointer)
+	if pointer == "" {
+		name = strings.TrimSuffix(path.Base(file), path.Ext(file))
+	}
+	name = strings.NewReplacer("~1", "/", "~0", "~").Replace(name)
+
+	taken := b.names[ref.kind]
+	if !taken[name] {
+		return name
+	}
+	if file != "" {
+		prefixed := snaker.ForceCamelIdentifier(strings.TrimSuffix(path.Base(file), path.Ext(file))) + name
+		if !taken[prefixed] {
+			return prefixed
+		}
+		name = prefixed
+	}
+	for i := 2; ; i++ {
+		if numbered := name + strconv.Itoa(i); !taken[numbered] {
+			return numbered
+		}
+	}
+}
+
+// splitRef splits ref into the file and the JSON pointer it references.
+func splitRef(ref string) (string, string) {
+	parts := strings.SplitN(ref, "#", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+func isLocalRef(ref string) bool {
+	return strings.HasPrefix(ref, "#")
+}
+
+// refLess orders references with a file before local ones, and then by their
+// text.
+func refLess(a, b string) bool {
+	if isLocalRef(a) != isLocalRef(b) {
+		return !isLocalRef(a)
+	}
+	return a < b
+}
+
+func componentRef(kind, name string) string {
+	return "#/components/" + kind + "/" + name
+}
+
+func bundleRefOf(source interface{}) (bundleRef, bool) {
+	switch r := source.(type) {
+	case *openapi3.SchemaRef:
+		return bundleRef{"schemas", r.Ref, valueOf(r.Value), func(ref string) { r.Ref = ref }}, true
+	case *openapi3.ParameterRef:
+		return bundleRef{"parameters", r.Ref, valueOf(r.Value), func(ref string) { r.Ref = ref }}, true
+	case *openapi3.HeaderRef:
+		return bundleRef{"headers", r.Ref, valueOf(r.Value), func(ref string) { r.Ref = ref }}, true
+	case *openapi3.RequestBodyRef:
+		return bundleRef{"requestBodies", r.Ref, valueOf(r.Value), func(ref string) { r.Ref = ref }}, true
+	case *openapi3.ResponseRef:
+		return bundleRef{"responses", r.Ref, valueOf(r.Value), func(ref string) { r.Ref = ref }}, true
+	case *openapi3.SecuritySchemeRef:
+		return bundleRef{"securitySchemes", r.Ref, valueOf(r.Value), func(ref string) { r.Ref = ref }}, true
+	case *openapi3.ExampleRef:
+		return bundleRef{"examples", r.Ref, valueOf(r.Value), func(ref string) { r.Ref = ref }}, true
+	case *openapi3.LinkRef:
+		return bundleRef{"links", r.Ref, valueOf(r.Value), func(ref string) { r.Ref = ref }}, true
+	case *openapi3.CallbackRef:
+		return bundleRef{"callbacks", r.Ref, valueOf(r.Value), func(ref string) { r.Ref = ref }}, true
+	}
+	return bundleRef{}, false
+}
+
+// valueOf returns v, or an untyped nil if v is a nil pointer, so that values
+// can be compared to nil.
+func valueOf(v interface{}) interface{} {
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return nil
+	}
+	return v
+}
+
+// componentRefs returns the components of kind by name.
+func componentRefs(c *openapi3.Components, kind string) map[string]bundleRef {
+	refs := make(map[string]bundleRef)
+	add := func(name string, source interface{}) {
+		refs[name], _ = bundleRefOf(source)
+	}
+	switch kind {
+	case "schemas":
+		for name, r := range c.Schemas {
+			add(name, r)
+		}
+	case "parameters":
+		for name, r := range c.Parameters {
+			add(name, r)
+		}
+	case "headers":
+		for name, r := range c.Headers {
+			add(name, r)
+		}
+	case "requestBodies":
+		for name, r := range c.RequestBodies {
+			add(name, r)
+		}
+	case "responses":
+		for name, r := range c.Responses {
+			add(name, r)
+		}
+	case "securitySchemes":
+		for name, r := range c.SecuritySchemes {
+			add(name, r)
+		}
+	case "examples":
+		for name, r := range c.Examples {
+			add(name, r)
+		}
+	case "links":
+		for name, r := range c.Links {
+			add(name, r)
+		}
+	case "callbacks":
+		for name, r := range c.Callbacks {
+			add(name, r)
+		}
+	}
+	return refs
+}
+
+// addComponent adds value to the components of kind as name.
+func addComponent(c *openapi3.Components, kind, name string, value interface{}) {
+	switch kind {
+	case "schemas":
+		if c.Schemas == nil {
+			c.Schemas = make(openapi3.Schemas)
+		}
+		c.Schemas[name] = &openapi3.SchemaRef{Value: value.(*openapi3.Schema)}
+	case "parameters":
+		if c.Parameters == nil {
+			c.Parameters = make(openapi3.ParametersMap)
+		}
+		c.Parameters[name] = &openapi3.ParameterRef{Value: value.(*openapi3.Parameter)}
+	case "headers":
+		if c.Headers == nil {
+			c.Headers = make(openapi3.Headers)
+		}
+		c.Headers[name] = &openapi3.HeaderRef{Value: value.(*openapi3.Header)}
+	case "requestBodies":
+		if c.RequestBodies == nil {
+			c.RequestBodies = make(openapi3.RequestBodies)
+		}
+		c.RequestBodies[name] = &openapi3.RequestBodyRef{Value: value.(*openapi3.RequestBody)}
+	case "responses":
+		if c.Responses == nil {
+			c.Responses = make(openapi3.Responses)
+		}
+		c.Responses[name] = &openapi3.ResponseRef{Value: value.(*openapi3.Response)}
+	case "securitySchemes":
+		if c.SecuritySchemes == nil {
+			c.SecuritySchemes = make(openapi3.SecuritySchemes)
+		}
+		c.SecuritySchemes[name] = &openapi3.SecuritySchemeRef{Value: value.(*openapi3.SecurityScheme)}
+	case "examples":
+		if c.Examples == nil {
+			c.Examples = make(openapi3.Examples)
+		}
+		c.Examples[name] = &openapi3.ExampleRef{Value: value.(*openapi3.Example)}
+	case "links":
+		if c.Links == nil {
+			c.Links = make(openapi3.Links)
+		}
+		c.Links[name] = &openapi3.LinkRef{Value: value.(*openapi3.Link)}
+	case "callbacks":
+		if c.Callbacks == nil {
+			c.Callbacks = make(openapi3.Callbacks)
+		}
+		c.Callbacks[name] = &openapi3.CallbackRef{Value: value.(*openapi3.Callback)}
+	}
+}
@@ -0,0 +1,170 @@
+package codegen
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadBundleTestSpec(t *testing.T) *openapi3.T {
+	files := map[string]string{
+		"root.yaml":          bundleTestRoot,
+		"common/common.yaml": bundleTestCommon,
+		"common/error.yaml":  bundleTestError,
+	}
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(_ *openapi3.Loader, uri *url.URL) ([]byte, error) {
+		data, ok := files[path.Clean(uri.Path)]
+		if !ok {
+			return nil, fmt.Errorf("file not found: %s", uri.Path)
+		}
+		return []byte(data), nil
+	}
+	swagger, err := loader.LoadFromFile("root.yaml")
+	require.NoError(t, err)
+	return swagger
+}
+
+func TestBundleSpec(t *testing.T) {
+	swagger := loadBundleTestSpec(t)
+	require.NoError(t, BundleSpec(swagger))
+
+	data, err := swagger.MarshalJSON()
+	require.NoError(t, err)
+
+	// The bundled spec loads without resolving any external references.
+	bundled, err := openapi3.NewLoader().LoadFromData(data)
+	require.NoError(t, err)
+
+	var schemas []string
+	for name := range bundled.Components.Schemas {
+		schemas = append(schemas, name)
+	}
+	sort.Strings(schemas)
+	assert.Equal(t, []string{"CommonPet", "Count", "Error", "Holder", "Owner", "Pet"}, schemas)
+	assert.Contains(t, bundled.Components.Parameters, "Limit")
+
+	get := bundled.Paths["/pets"].Get
+	assert.Equal(t, "#/components/parameters/Limit", get.Parameters[0].Ref)
+	assert.Equal(t, "#/components/schemas/Count", get.Parameters[0].Value.Schema.Ref)
+
+	pet := get.Responses["200"].Value.Content["application/json"].Schema
+	assert.Equal(t, "#/components/schemas/CommonPet", pet.Ref)
+	assert.Equal(t, "#/components/schemas/Owner", pet.Value.Properties["owner"].Ref)
+
+	owner := bundled.Components.Schemas["Owner"].Value
+	assert.Equal(t, "#/components/schemas/CommonPet", owner.Properties["pet"].Ref)
+
+	// The local Pet is left alone.
+	holder := bundled.Components.Schemas["Holder"].Value
+	assert.Equal(t, "#/components/schemas/Pet", holder.Properties["pet"].Ref)
+	assert.Contains(t, bundled.Components.Schemas["Pet"].Value.Properties, "local")
+
+	// Components referencing another file take the value in place.
+	assert.Empty(t, swagger.Components.Schemas["Error"].Ref)
+	assert.Contains(t, bundled.Components.Schemas["Error"].Value.Properties, "message")
+	assert.Equal(t, "#/components/schemas/Error", get.Responses["default"].Value.Content["application/json"].Schema.Ref)
+}
+
+func TestGenerateBundledSpec(t *testing.T) {
+	swagger := loadBundleTestSpec(t)
+
+	code, err := Generate(swagger, "api", Options{
+		GenerateTypes: true,
+		EmbedSpec:     true,
+		BundleSpec:    true,
+		ImportMapping: map[string]string{
+			"./common/common.yaml": "example.com/common",
+			"./common/error.yaml":  "example.com/common/errors",
+		},
+	})
+	require.NoError(t, err)
+
+	// Types still use the mapped packages, but the embedded spec doesn't.
+	assert.Contains(t, code, `"example.com/common"`)
+	assert.Contains(t, code, "func GetSwagger()")
+	assert.NotContains(t, code, ".PathToRawSpec(")
+}
+
+const bundleTestRoot = `
+openapi: 3.0.3
+info:
+  title: Bundle test
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      parameters:
+        - $ref: ./common/common.yaml#/components/parameters/Limit
+      responses:
+        '200':
+          description: pets
+          content:
+            application/json:
+              schema:
+                $ref: ./common/common.yaml#/components/schemas/Pet
+        default:
+          description: error
+          content:
+            application/json:
+              schema:
+                $ref: ./common/error.yaml
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        local:
+          type: string
+    Holder:
+      type: object
+      properties:
+        pet:
+          $ref: '#/components/schemas/Pet'
+    Error:
+      $ref: ./common/error.yaml
+`
+
+const bundleTestCommon = `
+openapi: 3.0.3
+info:
+  title: Common
+  version: 1.0.0
+paths: {}
+components:
+  parameters:
+    Limit:
+      name: limit
+      in: query
+      schema:
+        $ref: '#/components/schemas/Count'
+  schemas:
+    Count:
+      type: integer
+    Pet:
+      type: object
+      properties:
+        owner:
+          $ref: '#/components/schemas/Owner'
+    Owner:
+      type: object
+      properties:
+        pet:
+          $ref: '#/components/schemas/Pet'
+`
+
+const bundleTestError = `
+type: object
+properties:
+  message:
+    type: string
+`
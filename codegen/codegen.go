@@ -23,10 +23,10 @@ import (
 	"runtime/debug"
 	"sort"
 	"strings"
+	"sync"
 	"text/template"
 
 	"github.com/getkin/kin-openapi/openapi3"
-	"golang.org/x/tools/imports"
 
 	"github.com/discord-gophers/goapi-gen/templates"
 )
@@ -38,13 +38,18 @@ type Options struct {
 	GenerateServer bool              // GenerateChiServer specifies whether to generate chi server boilerplate
 	GenerateTypes  bool              // GenerateTypes specifies whether to generate type definitions
 	EmbedSpec      bool              // Whether to embed the swagger spec in the generated code
+	BundleSpec     bool              // Whether to bundle external references into the embedded spec
 	SkipFmt        bool              // Whether to skip go imports on the generated code
 	SkipPrune      bool              // Whether to skip pruning unused components on the generated code
+	Tracing        bool              // Whether to trace the operations of the generated server with OpenTelemetry
+	ReturnErrors   bool              // Whether handlers return an error along with their response
+	CollectErrors  bool              // Whether to bind every parameter and pass all their errors to the error handler at once
 	AliasTypes     bool              // Whether to alias types if possible
 	IncludeTags    []string          // Only include operations that have one of these tags. Ignored when empty.
 	ExcludeTags    []string          // Exclude operations that have one of these tags. Ignored when empty.
 	UserTemplates  map[string]string // Override built-in templates from user-provided files
 	ImportMapping  map[string]string // ImportMapping specifies the golang package path for each external reference
+	TypeMappings   map[string]string // TypeMappings specifies the Go type for schemas of a "type/format"
 	ExcludeSchemas []string          // Exclude from generation schemas with given names. Ignored when empty.
 }
 
@@ -74,7 +79,11 @@ func (im importMap) GoImports() []string {
 	return goImports
 }
 
-var importMapping importMap
+var (
+	importMapping importMap
+	// generateMu guards importMapping and typeMappings.
+	generateMu sync.Mutex
+)
 
 func constructImportMapping(input map[string]string) importMap {
 	var (
@@ -103,8 +112,29 @@ func constructImportMapping(input map[string]string) importMap {
 
 // Generate uses the Go templating engine to generate all of our server wrappers from
 // the descriptions we've built up above from the schema objects.
+// It is safe to call Generate concurrently for different specs.
 func Generate(swagger *openapi3.T, packageName string, opts Options) (string, error) {
+	code, _, err := NewGenerator(opts).Generate(swagger, packageName)
+	return code, err
+}
+
+// generate executes the templates for swagger with plugins, and returns the
+// unformatted code and the files emitted by plugins.
+func generate(swagger *openapi3.T, packageName string, opts Options, plugins []Plugin) (string, []File, error) {
+	// importMapping is shared package state, so concurrent calls are
+	// serialized until the code is generated. Formatting is done outside of
+	// the lock.
+	generateMu.Lock()
+	defer generateMu.Unlock()
+
 	importMapping = constructImportMapping(opts.ImportMapping)
+	mappings, err := constructTypeMapping(opts.TypeMappings)
+	if err != nil {
+		return "", nil, err
+	}
+	typeMappings = mappings
+	activePlugins = plugins
+	defer func() { typeMappings, activePlugins = nil, nil }()
 
 	filterOperationsByTag(swagger, opts)
 	if !opts.SkipPrune {
@@ -112,13 +142,24 @@ func Generate(swagger *openapi3.T, packageName string, opts Options) (string, er
 	}
 
 	// This creates the golang templates text package
-	TemplateFunctions["opts"] = func() Options { return opts }
-	t := template.New("goapi-gen").Funcs(TemplateFunctions)
+	funcs := make(template.FuncMap, len(TemplateFunctions)+1)
+	for name, fn := range TemplateFunctions {
+		funcs[name] = fn
+	}
+	for _, p := range plugins {
+		if h, ok := p.(TemplateFuncsPlugin); ok {
+			for name, fn := range h.TemplateFuncs() {
+				funcs[name] = fn
+			}
+		}
+	}
+	funcs["opts"] = func() Options { return opts }
+	t := template.New("goapi-gen").Funcs(funcs)
 	// This parses all of our own template files into the template object
 	// above
-	t, err := templates.Parse(t)
+	t, err = templates.Parse(t)
 	if err != nil {
-		return "", fmt.Errorf("error parsing goapi-gen templates: %w", err)
+		return "", nil, fmt.Errorf("error parsing goapi-gen templates: %w", err)
 	}
 
 	// Override built-in templates with user-provided versions
@@ -126,7 +167,7 @@ func Generate(swagger *openapi3.T, packageName string, opts Options) (string, er
 		if _, ok := opts.UserTemplates[tpl.Name()]; ok {
 			utpl := t.New(tpl.Name())
 			if _, err := utpl.Parse(opts.UserTemplates[tpl.Name()]); err != nil {
-				return "", fmt.Errorf("error parsing user-provided template %q: %w", tpl.Name(), err)
+				return "", nil, fmt.Errorf("error parsing user-provided template %q: %w", tpl.Name(), err)
 			}
 		}
 	}
@@ -134,7 +175,7 @@ func Generate(swagger *openapi3.T, packageName string, opts Options) (string, er
 	var finalCustomImports []string
 	ops, err := OperationDefinitions(swagger)
 	if err != nil {
-		return "", fmt.Errorf("error creating operation definitions: %w", err)
+		return "", nil, fmt.Errorf("error creating operation definitions: %w", err)
 	}
 
 	for _, op := range ops {
@@ -146,12 +187,12 @@ func Generate(swagger *openapi3.T, packageName string, opts Options) (string, er
 	if opts.GenerateTypes {
 		typeDefinitions, customImports, err = GenerateTypeDefinitions(t, swagger, ops, opts.ExcludeSchemas)
 		if err != nil {
-			return "", fmt.Errorf("error generating type definitions: %w", err)
+			return "", nil, fmt.Errorf("error generating type definitions: %w", err)
 		}
 
 		constantDefinitions, err = GenerateConstants(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error generating constants: %w", err)
+			return "", nil, fmt.Errorf("error generating constants: %w", err)
 		}
 
 	}
@@ -159,19 +200,33 @@ func Generate(swagger *openapi3.T, packageName string, opts Options) (string, er
 	// TODO: check for exact double imports and merge them together with 1 alias, otherwise we might run into double imports under different names
 	finalCustomImports = append(finalCustomImports, customImports...)
 
-	var serverOut string
+	var serverURLs, serverOut string
 	if opts.GenerateServer {
+		serverURLs, err = GenerateServerURLs(t, swagger.Servers)
+		if err != nil {
+			return "", nil, fmt.Errorf("error generating server URLs: %w", err)
+		}
+
 		serverOut, err = GenerateChiServer(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
+			return "", nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
 		}
 	}
 
 	var inlinedSpec string
 	if opts.EmbedSpec {
-		inlinedSpec, err = GenerateInlinedSpec(t, importMapping, swagger)
+		specImports := importMapping
+		if opts.BundleSpec {
+			if err := BundleSpec(swagger); err != nil {
+				return "", nil, fmt.Errorf("error bundling spec: %w", err)
+			}
+			// The bundled spec doesn't need the specs embedded in the
+			// packages of its external references.
+			specImports = nil
+		}
+		inlinedSpec, err = GenerateInlinedSpec(t, specImports, swagger)
 		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
+			return "", nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
 		}
 	}
 
@@ -182,85 +237,71 @@ func Generate(swagger *openapi3.T, packageName string, opts Options) (string, er
 	externalImports = append(externalImports, finalCustomImports...)
 	importsOut, err := GenerateImports(t, externalImports, packageName)
 	if err != nil {
-		return "", fmt.Errorf("error generating imports: %w", err)
+		return "", nil, fmt.Errorf("error generating imports: %w", err)
 	}
 
 	_, err = w.WriteString(importsOut)
 	if err != nil {
-		return "", fmt.Errorf("error writing imports: %w", err)
+		return "", nil, fmt.Errorf("error writing imports: %w", err)
 	}
 
 	_, err = w.WriteString(constantDefinitions)
 	if err != nil {
-		return "", fmt.Errorf("error writing constants: %w", err)
+		return "", nil, fmt.Errorf("error writing constants: %w", err)
 	}
 
 	_, err = w.WriteString(typeDefinitions)
 	if err != nil {
-		return "", fmt.Errorf("error writing type definitions: %w", err)
+		return "", nil, fmt.Errorf("error writing type definitions: %w", err)
 	}
 
 	if opts.GenerateServer {
+		_, err = w.WriteString(serverURLs)
+		if err != nil {
+			return "", nil, fmt.Errorf("error writing server URLs: %w", err)
+		}
+
 		_, err = w.WriteString(serverOut)
 		if err != nil {
-			return "", fmt.Errorf("error writing server path handlers: %w", err)
+			return "", nil, fmt.Errorf("error writing server path handlers: %w", err)
 		}
 	}
 
 	if opts.EmbedSpec {
 		_, err = w.WriteString(inlinedSpec)
 		if err != nil {
-			return "", fmt.Errorf("error writing inlined spec: %w", err)
+			return "", nil, fmt.Errorf("error writing inlined spec: %w", err)
 		}
 	}
 
 	err = w.Flush()
 	if err != nil {
-		return "", fmt.Errorf("error flushing output buffer: %w", err)
-	}
-
-	// remove any byte-order-marks which break Go-Code
-	goCode := SanitizeCode(buf.String())
-
-	// The generation code produces unindented horrors. Use the Go Imports
-	// to make it all pretty.
-	if opts.SkipFmt {
-		return goCode, nil
+		return "", nil, fmt.Errorf("error flushing output buffer: %w", err)
 	}
 
-	outBytes, err := imports.Process(packageName+".go", []byte(goCode), nil)
+	files, err := pluginFiles(FileContext{
+		PackageName: packageName,
+		Options:     opts,
+		Swagger:     swagger,
+		Operations:  ops,
+		Templates:   t,
+	})
 	if err != nil {
-		return "", fmt.Errorf("error formatting Go code: %w", err)
+		return "", nil, err
 	}
-	return string(outBytes), nil
+
+	// remove any byte-order-marks which break Go-Code
+	return SanitizeCode(buf.String()), files, nil
 }
 
 // GenerateTypeDefinitions produces the type definitions in ops and executes
 // the template.
 func GenerateTypeDefinitions(t *template.Template, swagger *openapi3.T, ops []OperationDefinition, excludeSchemas []string) (string, []string, error) {
-	schemaTypes, err := GenerateTypesForSchemas(t, swagger.Components.Schemas, excludeSchemas)
+	allTypes, err := componentTypeDefinitions(t, swagger, excludeSchemas)
 	if err != nil {
-		return "", nil, fmt.Errorf("error generating Go types for component schemas: %w", err)
+		return "", nil, err
 	}
 
-	paramTypes, err := GenerateTypesForParameters(t, swagger.Components.Parameters)
-	if err != nil {
-		return "", nil, fmt.Errorf("error generating Go types for component parameters: %w", err)
-	}
-	allTypes := append(schemaTypes, paramTypes...)
-
-	responseTypes, err := GenerateTypesForResponses(t, swagger.Components.Responses)
-	if err != nil {
-		return "", nil, fmt.Errorf("error generating Go types for component responses: %w", err)
-	}
-	allTypes = append(allTypes, responseTypes...)
-
-	bodyTypes, err := GenerateTypesForRequestBodies(t, swagger.Components.RequestBodies)
-	if err != nil {
-		return "", nil, fmt.Errorf("error generating Go types for component request bodies: %w", err)
-	}
-	allTypes = append(allTypes, bodyTypes...)
-
 	paramTypesOut, err := GenerateTypesForOperations(t, ops)
 	if err != nil {
 		return "", nil, fmt.Errorf("error generating Go types for component request bodies: %w", err)
@@ -298,6 +339,35 @@ func GenerateTypeDefinitions(t *template.Template, swagger *openapi3.T, ops []Op
 	return typeDefinitions, customImports, nil
 }
 
+// componentTypeDefinitions returns the type definitions for everything under
+// the components section of swagger.
+func componentTypeDefinitions(t *template.Template, swagger *openapi3.T, excludeSchemas []string) ([]TypeDefinition, error) {
+	schemaTypes, err := GenerateTypesForSchemas(t, swagger.Components.Schemas, excludeSchemas)
+	if err != nil {
+		return nil, fmt.Errorf("error generating Go types for component schemas: %w", err)
+	}
+
+	paramTypes, err := GenerateTypesForParameters(t, swagger.Components.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("error generating Go types for component parameters: %w", err)
+	}
+	allTypes := append(schemaTypes, paramTypes...)
+
+	responseTypes, err := GenerateTypesForResponses(t, swagger.Components.Responses)
+	if err != nil {
+		return nil, fmt.Errorf("error generating Go types for component responses: %w", err)
+	}
+	allTypes = append(allTypes, responseTypes...)
+
+	bodyTypes, err := GenerateTypesForRequestBodies(t, swagger.Components.RequestBodies)
+	if err != nil {
+		return nil, fmt.Errorf("error generating Go types for component request bodies: %w", err)
+	}
+	allTypes = append(allTypes, bodyTypes...)
+
+	return allTypes, nil
+}
+
 // GenerateConstants creates operation ids, context keys, paths, etc. to be
 // exported as constants
 func GenerateConstants(t *template.Template, ops []OperationDefinition) (string, error) {
@@ -1,7 +1,9 @@
 package codegen
 
 import (
+	"encoding/json"
 	"go/format"
+	"strings"
 	"testing"
 	"text/template"
 
@@ -331,6 +333,135 @@ components:
           enum: [car, dog, oldage]
 `
 
+func TestEnumVarNames(t *testing.T) {
+	loader := openapi3.NewLoader()
+	swagger, err := loader.LoadFromData([]byte(enumVarNamesSpec))
+	assert.NoError(t, err)
+
+	code, err := Generate(swagger, "api", Options{GenerateTypes: true, SkipPrune: true})
+	assert.NoError(t, err)
+
+	assert.Contains(t, code, "// Delivered to the customer.\n\tStatusDone = Status{\"delivered\"}")
+	assert.Regexp(t, `(?s)return \[\]Status\{\s+StatusTodo,\s+StatusInProgress,\s+StatusDone,\s+\}`, code)
+
+	swagger, err = loader.LoadFromData([]byte(strings.Replace(enumVarNamesSpec, ", Done]", "]", 1)))
+	assert.NoError(t, err)
+	_, err = Generate(swagger, "api", Options{GenerateTypes: true, SkipPrune: true})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `"x-enum-varnames" has 2 names for 3 enum values`)
+	}
+}
+
+func TestExtensibleEnum(t *testing.T) {
+	loader := openapi3.NewLoader()
+	swagger, err := loader.LoadFromData([]byte(extensibleEnumSpec))
+	assert.NoError(t, err)
+
+	code, err := Generate(swagger, "api", Options{GenerateTypes: true, SkipPrune: true})
+	assert.NoError(t, err)
+	assert.Contains(t, code, "func (t *Kind) FromValue(value string) error {\n\tt.value = value\n\treturn nil\n}")
+	assert.Contains(t, code, "func (t Kind) IsKnown() bool {")
+	assert.Contains(t, code, `KindCat = Kind{"cat"}`)
+	assert.Contains(t, code, `SizeSmall = Size{"small"}`)
+
+	swagger, err = loader.LoadFromData([]byte(strings.Replace(extensibleEnumSpec, "x-extensible-enum: true", "x-extensible-enum: [huge]", 1)))
+	assert.NoError(t, err)
+	_, err = Generate(swagger, "api", Options{GenerateTypes: true, SkipPrune: true})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `"x-extensible-enum" can't list values of a schema with an enum`)
+	}
+}
+
+func TestMiddlewareChain(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(middlewareChainSpec))
+	assert.NoError(t, err)
+
+	ops, err := OperationDefinitions(swagger)
+	assert.NoError(t, err)
+	if assert.Len(t, ops, 2) {
+		assert.Equal(t, []string{"auth", "audit", "admin", "pets", "limit"}, ops[0].Middlewares)
+		assert.Equal(t, []string{"auth", "pets"}, ops[1].Middlewares)
+	}
+
+	code, err := Generate(swagger, "api", Options{GenerateServer: true})
+	assert.NoError(t, err)
+	assert.Contains(t, code, `handler = siw.Middlewares.Auth(handler).ServeHTTP
+	handler = siw.Middlewares.Audit(handler).ServeHTTP
+	handler = siw.Middlewares.Admin(handler).ServeHTTP
+	handler = siw.Middlewares.Pets(handler).ServeHTTP
+	handler = siw.Middlewares.Limit(handler).ServeHTTP`)
+	assert.Contains(t, code, `return nil, errors.New("goapi-gen: could not find tagged middleware audit (Audit)")`)
+
+	swagger.Tags[0].Extensions[extMiddlewares] = json.RawMessage(`"audit"`)
+	_, err = OperationDefinitions(swagger)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `tag admin: invalid value for "x-go-middlewares"`)
+	}
+}
+
+const middlewareChainSpec = `
+openapi: 3.0.1
+info:
+  title: Middleware chain test
+  version: 1.0.0
+x-go-middlewares: [auth]
+tags:
+  - name: admin
+    x-go-middlewares: [audit, admin]
+  - name: public
+paths:
+  /pets:
+    x-go-middlewares: [pets, auth]
+    delete:
+      operationId: deletePets
+      tags: [admin, public]
+      x-go-middlewares: [limit]
+      responses:
+        '204':
+          description: deleted
+    get:
+      operationId: listPets
+      tags: [public]
+      responses:
+        '204':
+          description: listed
+`
+
+const extensibleEnumSpec = `
+openapi: 3.0.1
+info:
+  title: Extensible enum test
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Kind:
+      type: string
+      x-extensible-enum: [cat, dog]
+    Size:
+      type: string
+      enum: [small, large]
+      x-extensible-enum: true
+`
+
+const enumVarNamesSpec = `
+openapi: 3.0.1
+info:
+  title: Enum test
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Status:
+      type: string
+      enum: [new, in_progress, delivered]
+      x-enum-varnames: [Todo, InProgress, Done]
+      x-enum-descriptions:
+        - ""
+        - Being worked on.
+        - Delivered to the customer.
+`
+
 func TestGenerateEnumTypes(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -358,6 +489,18 @@ type MyType struct {
 func (t *MyType) ToValue() string {
     return t.value
 }
+func (t MyType) String() string {
+    return fmt.Sprint(t.value)
+}
+func (t MyType) IsValid() bool {
+    switch t.value {
+    
+    case some.value:
+        return true
+    
+    }
+    return false
+}
 func (t MyType) MarshalJSON() ([]byte, error) {
     return json.Marshal(t.value)
 }
@@ -368,6 +511,12 @@ func (t *MyType) UnmarshalJSON(data []byte) error {
     }
     return t.FromValue(value)
 }
+func (t MyType) MarshalText() ([]byte, error) {
+    return []byte(t.String()), nil
+}
+func (t *MyType) UnmarshalText(data []byte) error {
+    return t.FromValue(string(data))
+}
 func (t *MyType) FromValue(value string) error {
     switch value {
     
@@ -400,6 +549,18 @@ type MyType struct {
 func (t *MyType) ToValue() int64 {
     return t.value
 }
+func (t MyType) String() string {
+    return fmt.Sprint(t.value)
+}
+func (t MyType) IsValid() bool {
+    switch t.value {
+    
+    case some.value:
+        return true
+    
+    }
+    return false
+}
 func (t MyType) MarshalJSON() ([]byte, error) {
     return json.Marshal(t.value)
 }
@@ -410,6 +571,16 @@ func (t *MyType) UnmarshalJSON(data []byte) error {
     }
     return t.FromValue(value)
 }
+func (t MyType) MarshalText() ([]byte, error) {
+    return []byte(t.String()), nil
+}
+func (t *MyType) UnmarshalText(data []byte) error {
+    var value int64
+    if err := runtime.BindStringToObject(string(data), &value); err != nil {
+        return err
+    }
+    return t.FromValue(value)
+}
 func (t *MyType) FromValue(value int64) error {
     switch value {
     
@@ -0,0 +1,457 @@
+package codegen
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/kenshaw/snaker"
+)
+
+// APIChange describes a single difference between the Go code generated for
+// two versions of a spec.
+type APIChange struct {
+	Subject  string `json:"subject"`  // Go identifier the change applies to, eg Pet.Name or FindPets
+	Breaking bool   `json:"breaking"` // Whether code using the old version may stop compiling or working
+	Message  string `json:"message"`
+}
+
+// String returns the change in a "kind: subject: message" format.
+func (c APIChange) String() string {
+	kind := "non-breaking"
+	if c.Breaking {
+		kind = "breaking"
+	}
+	return fmt.Sprintf("%s: %s: %s", kind, c.Subject, c.Message)
+}
+
+// apiDescription is the subset of generated code compared by DiffAPI.
+type apiDescription struct {
+	ops   map[string]OperationDefinition
+	types map[string]TypeDefinition
+}
+
+// describeAPI runs the same passes as Generate over swagger, and indexes the
+// resulting operations and types by their Go name.
+func describeAPI(swagger *openapi3.T, opts Options) (apiDescription, error) {
+	filterOperationsByTag(swagger, opts)
+	if !opts.SkipPrune {
+		pruneUnusedComponents(swagger)
+	}
+
+	ops, err := OperationDefinitions(swagger)
+	if err != nil {
+		return apiDescription{}, fmt.Errorf("error creating operation definitions: %w", err)
+	}
+
+	types, err := componentTypeDefinitions(nil, swagger, opts.ExcludeSchemas)
+	if err != nil {
+		return apiDescription{}, err
+	}
+
+	desc := apiDescription{
+		ops:   make(map[string]OperationDefinition, len(ops)),
+		types: make(map[string]TypeDefinition, len(types)),
+	}
+	for _, op := range ops {
+		desc.ops[op.OperationID] = op
+		for _, td := range op.TypeDefinitions {
+			// Parameter objects are compared as part of their operation.
+			if td.TypeName == op.OperationID+"Params" {
+				continue
+			}
+			types = append(types, td)
+		}
+	}
+	for _, td := range types {
+		if _, ok := desc.types[td.TypeName]; !ok {
+			desc.types[td.TypeName] = td
+		}
+	}
+	return desc, nil
+}
+
+// DiffAPI compares the Go code which would be generated for oldSwagger and
+// newSwagger with opts, and returns the changes between them, sorted by
+// subject. Changes which may break code written against the old version,
+// such as removed operations, changed parameter types, newly required fields
+// or removed enum values, are marked as breaking.
+//
+// DiffAPI modifies both specs in the same way Generate does.
+func DiffAPI(oldSwagger, newSwagger *openapi3.T, opts Options) ([]APIChange, error) {
+	generateMu.Lock()
+	defer generateMu.Unlock()
+
+	importMapping = constructImportMapping(opts.ImportMapping)
+	mappings, err := constructTypeMapping(opts.TypeMappings)
+	if err != nil {
+		return nil, err
+	}
+	typeMappings = mappings
+	defer func() { typeMappings = nil }()
+
+	oldAPI, err := describeAPI(oldSwagger, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error reading old spec: %w", err)
+	}
+	newAPI, err := describeAPI(newSwagger, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error reading new spec: %w", err)
+	}
+
+	var d differ
+	d.diffOperations(oldAPI.ops, newAPI.ops)
+	d.diffTypes(oldAPI.types, newAPI.types)
+
+	sort.SliceStable(d.changes, func(i, j int) bool {
+		return d.changes[i].Subject < d.changes[j].Subject
+	})
+	return d.changes, nil
+}
+
+type differ struct {
+	changes []APIChange
+}
+
+func (d *differ) report(subject string, breaking bool, format string, args ...interface{}) {
+	d.changes = append(d.changes, APIChange{
+		Subject:  subject,
+		Breaking: breaking,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (d *differ) diffOperations(oldOps, newOps map[string]OperationDefinition) {
+	// Operations are matched by route first, so that a changed operationId is
+	// reported as a rename instead of a removal and an addition.
+	newByRoute := make(map[string]string, len(newOps))
+	for id, op := range newOps {
+		newByRoute[op.Method+" "+op.Path] = id
+	}
+	matched := make(map[string]bool)
+
+	for _, id := range sortedKeys(oldOps) {
+		oldOp := oldOps[id]
+		route := oldOp.Method + " " + oldOp.Path
+		newOp, ok := newOps[id]
+		if !ok {
+			if newID, ok := newByRoute[route]; ok {
+				if _, ok := oldOps[newID]; !ok {
+					matched[newID] = true
+					d.report(id, true, "operation (%s) was renamed to %s", route, newID)
+					d.diffOperation(newID, oldOp, newOps[newID])
+					continue
+				}
+			}
+			d.report(id, true, "operation (%s) was removed", route)
+			continue
+		}
+		matched[id] = true
+
+		if newRoute := newOp.Method + " " + newOp.Path; newRoute != route {
+			d.report(id, true, "route changed from %s to %s", route, newRoute)
+		}
+		d.diffOperation(id, oldOp, newOp)
+	}
+
+	for _, id := range sortedKeys(newOps) {
+		if _, ok := oldOps[id]; ok || matched[id] {
+			continue
+		}
+		op := newOps[id]
+		d.report(id, false, "operation (%s %s) was added", op.Method, op.Path)
+	}
+}
+
+func (d *differ) diffOperation(id string, oldOp, newOp OperationDefinition) {
+	// Path parameters are positional arguments of the handler, so any change
+	// to their number or types changes its signature.
+	if len(oldOp.PathParams) != len(newOp.PathParams) {
+		d.report(id, true, "number of path parameters changed from %d to %d", len(oldOp.PathParams), len(newOp.PathParams))
+	} else {
+		for i, oldParam := range oldOp.PathParams {
+			newParam := newOp.PathParams[i]
+			if oldParam.TypeDef() != newParam.TypeDef() {
+				d.report(id, true, "type of path parameter %s changed from %s to %s", newParam.ParamName, oldParam.TypeDef(), newParam.TypeDef())
+			}
+		}
+	}
+
+	d.diffParams(id, "query", oldOp.QueryParams, newOp.QueryParams)
+	d.diffParams(id, "header", oldOp.HeaderParams, newOp.HeaderParams)
+	d.diffParams(id, "cookie", oldOp.CookieParams, newOp.CookieParams)
+
+	oldBodies := make(map[string]RequestBodyDefinition)
+	for _, body := range oldOp.Bodies {
+		oldBodies[body.ContentType] = body
+	}
+	newBodies := make(map[string]RequestBodyDefinition)
+	for _, body := range newOp.Bodies {
+		newBodies[body.ContentType] = body
+	}
+	for _, contentType := range sortedKeys(oldBodies) {
+		oldBody := oldBodies[contentType]
+		newBody, ok := newBodies[contentType]
+		if !ok {
+			d.report(id, true, "%s request body was removed", contentType)
+			continue
+		}
+		if oldBody.Schema.TypeDecl() != newBody.Schema.TypeDecl() {
+			d.report(id, true, "type of %s request body changed from %s to %s", contentType, oldBody.Schema.TypeDecl(), newBody.Schema.TypeDecl())
+		}
+		if !oldBody.Required && newBody.Required {
+			d.report(id, true, "%s request body became required", contentType)
+		}
+	}
+	for _, contentType := range sortedKeys(newBodies) {
+		if _, ok := oldBodies[contentType]; ok {
+			continue
+		}
+		d.report(id, newOp.BodyRequired && len(oldBodies) == 0, "%s request body was added", contentType)
+	}
+
+	d.diffResponses(id, oldOp, newOp)
+}
+
+func (d *differ) diffParams(id, in string, oldParams, newParams []ParameterDefinition) {
+	oldByName := make(map[string]ParameterDefinition, len(oldParams))
+	for _, p := range oldParams {
+		oldByName[p.ParamName] = p
+	}
+	newByName := make(map[string]ParameterDefinition, len(newParams))
+	for _, p := range newParams {
+		newByName[p.ParamName] = p
+	}
+
+	for _, name := range sortedKeys(oldByName) {
+		oldParam := oldByName[name]
+		newParam, ok := newByName[name]
+		if !ok {
+			d.report(id, true, "%s parameter %s was removed", in, name)
+			continue
+		}
+		if oldParam.TypeDef() != newParam.TypeDef() {
+			d.report(id, true, "type of %s parameter %s changed from %s to %s", in, name, oldParam.TypeDef(), newParam.TypeDef())
+		}
+		if oldParam.Required != newParam.Required {
+			// The field in the Params object changes between T and *T.
+			d.report(id, true, "%s parameter %s became %s", in, name, requiredness(newParam.Required))
+		}
+	}
+	for _, name := range sortedKeys(newByName) {
+		if _, ok := oldByName[name]; ok {
+			continue
+		}
+		newParam := newByName[name]
+		d.report(id, newParam.Required, "%s %s parameter %s was added", requiredness(newParam.Required), in, name)
+	}
+}
+
+func (d *differ) diffResponses(id string, oldOp, newOp OperationDefinition) {
+	responses := func(op OperationDefinition) map[string]ResponseTypeDefinition {
+		tds, err := op.GetResponseTypeDefinitions()
+		if err != nil {
+			// OperationDefinitions already generated these schemas successfully.
+			return nil
+		}
+		m := make(map[string]ResponseTypeDefinition, len(tds))
+		for _, td := range tds {
+			m[snaker.ForceCamelIdentifier(op.OperationID)+TitleWord(td.TypeName)+"Response"] = td
+		}
+		return m
+	}
+	oldResponses, newResponses := responses(oldOp), responses(newOp)
+
+	for _, name := range sortedKeys(oldResponses) {
+		oldResp := oldResponses[name]
+		newResp, ok := newResponses[name]
+		if !ok {
+			d.report(name, true, "response constructor for %s %s was removed", oldResp.ResponseName, oldResp.ContentTypeName)
+			continue
+		}
+		if oldResp.Schema.TypeDecl() != newResp.Schema.TypeDecl() {
+			d.report(name, true, "body type changed from %s to %s", oldResp.Schema.TypeDecl(), newResp.Schema.TypeDecl())
+		}
+	}
+	for _, name := range sortedKeys(newResponses) {
+		if _, ok := oldResponses[name]; ok {
+			continue
+		}
+		newResp := newResponses[name]
+		d.report(name, false, "response constructor for %s %s was added", newResp.ResponseName, newResp.ContentTypeName)
+	}
+}
+
+func (d *differ) diffTypes(oldTypes, newTypes map[string]TypeDefinition) {
+	var removed, added []string
+	for _, name := range sortedKeys(oldTypes) {
+		newType, ok := newTypes[name]
+		if !ok {
+			removed = append(removed, name)
+			continue
+		}
+		d.diffSchema(name, oldTypes[name].Schema, newType.Schema)
+	}
+	for _, name := range sortedKeys(newTypes) {
+		if _, ok := oldTypes[name]; !ok {
+			added = append(added, name)
+		}
+	}
+
+	// A removed type whose definition matches exactly one added type, and
+	// the other way around, is reported as a rename.
+	renamedTo := make(map[string]string)
+	renamedFrom := make(map[string]string)
+	for _, oldName := range removed {
+		var candidates []string
+		for _, newName := range added {
+			if oldTypes[oldName].Schema.TypeDecl() == newTypes[newName].Schema.TypeDecl() {
+				candidates = append(candidates, newName)
+			}
+		}
+		if len(candidates) != 1 {
+			continue
+		}
+		if _, taken := renamedFrom[candidates[0]]; taken {
+			// Ambiguous, fall back to reporting a removal and an addition.
+			delete(renamedTo, renamedFrom[candidates[0]])
+			continue
+		}
+		renamedTo[oldName] = candidates[0]
+		renamedFrom[candidates[0]] = oldName
+	}
+
+	for _, name := range removed {
+		if newName, ok := renamedTo[name]; ok {
+			d.report(name, true, "type was renamed to %s", newName)
+			continue
+		}
+		d.report(name, true, "type was removed")
+	}
+	for _, name := range added {
+		if oldName, ok := renamedFrom[name]; ok && renamedTo[oldName] == name {
+			continue
+		}
+		d.report(name, false, "type was added")
+	}
+}
+
+func (d *differ) diffSchema(name string, oldSchema, newSchema Schema) {
+	if len(oldSchema.EnumValues) != 0 || len(newSchema.EnumValues) != 0 {
+		if oldSchema.GoType != newSchema.GoType {
+			d.report(name, true, "type changed from %s to %s", oldSchema.GoType, newSchema.GoType)
+		}
+		if oldSchema.ExtensibleEnum && !newSchema.ExtensibleEnum {
+			d.report(name, true, "enum is no longer extensible")
+		}
+		d.diffEnumValues(name, oldSchema.EnumValues, newSchema.EnumValues)
+		return
+	}
+
+	if oldSchema.RefType == "" && newSchema.RefType == "" && (len(oldSchema.Properties) != 0 || len(newSchema.Properties) != 0) {
+		before := len(d.changes)
+		d.diffProperties(name, oldSchema.Properties, newSchema.Properties)
+		if oldSchema.HasAdditionalProperties != newSchema.HasAdditionalProperties {
+			d.report(name, true, "additional properties became %s", allowedness(newSchema.HasAdditionalProperties))
+		} else if oldSchema.HasAdditionalProperties && oldSchema.AdditionalPropertiesType.TypeDecl() != newSchema.AdditionalPropertiesType.TypeDecl() {
+			d.report(name, true, "type of additional properties changed from %s to %s",
+				oldSchema.AdditionalPropertiesType.TypeDecl(), newSchema.AdditionalPropertiesType.TypeDecl())
+		}
+		// Embedded types from allOf are not part of Properties.
+		if len(d.changes) == before && oldSchema.GoType != newSchema.GoType {
+			d.report(name, true, "type definition changed")
+		}
+		return
+	}
+
+	if oldSchema.TypeDecl() != newSchema.TypeDecl() {
+		d.report(name, true, "type changed from %s to %s", oldSchema.TypeDecl(), newSchema.TypeDecl())
+	}
+}
+
+func (d *differ) diffProperties(name string, oldProps, newProps []Property) {
+	oldByName := make(map[string]Property, len(oldProps))
+	for _, p := range oldProps {
+		oldByName[p.JSONFieldName] = p
+	}
+	newByName := make(map[string]Property, len(newProps))
+	for _, p := range newProps {
+		newByName[p.JSONFieldName] = p
+	}
+
+	for _, field := range sortedKeys(oldByName) {
+		oldProp := oldByName[field]
+		subject := name + "." + oldProp.GoFieldName()
+		newProp, ok := newByName[field]
+		if !ok {
+			d.report(subject, true, "field was removed")
+			continue
+		}
+		if oldProp.Required != newProp.Required {
+			d.report(subject, true, "field became %s", requiredness(newProp.Required))
+		} else if oldProp.GoTypeDef() != newProp.GoTypeDef() {
+			d.report(subject, true, "type changed from %s to %s", oldProp.GoTypeDef(), newProp.GoTypeDef())
+		}
+	}
+	for _, field := range sortedKeys(newByName) {
+		if _, ok := oldByName[field]; ok {
+			continue
+		}
+		newProp := newByName[field]
+		d.report(name+"."+newProp.GoFieldName(), newProp.Required, "%s field was added", requiredness(newProp.Required))
+	}
+}
+
+func (d *differ) diffEnumValues(name string, oldValues, newValues map[string]string) {
+	// EnumValues maps constant names to values.
+	newConsts := make(map[string]string, len(newValues))
+	for constName, value := range newValues {
+		newConsts[value] = constName
+	}
+	oldConsts := make(map[string]string, len(oldValues))
+	for constName, value := range oldValues {
+		oldConsts[value] = constName
+	}
+
+	for _, value := range sortedKeys(oldConsts) {
+		newConst, ok := newConsts[value]
+		if !ok {
+			d.report(name, true, "enum value %q was removed", value)
+			continue
+		}
+		if oldConst := oldConsts[value]; oldConst != newConst {
+			d.report(name, true, "constant for enum value %q was renamed from %s to %s", value, oldConst, newConst)
+		}
+	}
+	for _, value := range sortedKeys(newConsts) {
+		if _, ok := oldConsts[value]; !ok {
+			d.report(name, false, "enum value %q was added", value)
+		}
+	}
+}
+
+func requiredness(required bool) string {
+	if required {
+		return "required"
+	}
+	return "optional"
+}
+
+func allowedness(allowed bool) string {
+	if allowed {
+		return "allowed"
+	}
+	return "disallowed"
+}
+
+// sortedKeys returns the keys of the string keyed map m in sorted order.
+func sortedKeys(m interface{}) []string {
+	mapKeys := reflect.ValueOf(m).MapKeys()
+	keys := make([]string, 0, len(mapKeys))
+	for _, k := range mapKeys {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
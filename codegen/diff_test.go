@@ -0,0 +1,208 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffAPI(t *testing.T) {
+	oldSwagger, err := openapi3.NewLoader().LoadFromData([]byte(diffTestSpecOld))
+	require.NoError(t, err)
+	newSwagger, err := openapi3.NewLoader().LoadFromData([]byte(diffTestSpecNew))
+	require.NoError(t, err)
+
+	changes, err := DiffAPI(oldSwagger, newSwagger, Options{SkipPrune: true})
+	require.NoError(t, err)
+
+	var got []string
+	for _, c := range changes {
+		got = append(got, c.String())
+	}
+	assert.Equal(t, []string{
+		"breaking: Animal: type was renamed to Creature",
+		"breaking: DeletePet: operation (DELETE /pets/{id}) was removed",
+		"breaking: FindPets: query parameter limit became required",
+		"breaking: FindPets: type of query parameter offset changed from int32 to string",
+		"non-breaking: FindPets: optional query parameter tags was added",
+		"breaking: GetPet: operation (GET /pets/{id}) was renamed to ShowPet",
+		"breaking: GetPetJSON200Response: response constructor for 200 application/json was removed",
+		"breaking: Pet.Name: field became required",
+		"breaking: Pet.Owner: field was removed",
+		"non-breaking: Pet.Tag: optional field was added",
+		"breaking: PetKind: enum is no longer extensible",
+		"breaking: PetKind: enum value \"bird\" was removed",
+		"non-breaking: PetKind: enum value \"fish\" was added",
+		"breaking: ShowPet: type of path parameter id changed from int64 to string",
+		"non-breaking: ShowPetJSON200Response: response constructor for 200 application/json was added",
+	}, got)
+}
+
+func TestDiffAPINoChanges(t *testing.T) {
+	oldSwagger, err := openapi3.NewLoader().LoadFromData([]byte(testOpenAPIDefinition))
+	require.NoError(t, err)
+	newSwagger, err := openapi3.NewLoader().LoadFromData([]byte(testOpenAPIDefinition))
+	require.NoError(t, err)
+
+	changes, err := DiffAPI(oldSwagger, newSwagger, Options{})
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+const diffTestSpecOld = `
+openapi: 3.0.1
+info:
+  title: Diff test
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: findPets
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+            format: int32
+        - name: offset
+          in: query
+          schema:
+            type: integer
+            format: int32
+      responses:
+        '200':
+          description: pets
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Pet'
+  /pets/{id}:
+    get:
+      operationId: getPet
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: integer
+            format: int64
+      responses:
+        '200':
+          description: pet
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+    delete:
+      operationId: deletePet
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: integer
+            format: int64
+      responses:
+        '204':
+          description: deleted
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [id]
+      properties:
+        id:
+          type: integer
+          format: int64
+        name:
+          type: string
+        owner:
+          type: string
+    PetKind:
+      type: string
+      enum: [cat, dog, bird]
+      x-extensible-enum: true
+    Animal:
+      type: object
+      properties:
+        legs:
+          type: integer
+`
+
+const diffTestSpecNew = `
+openapi: 3.0.1
+info:
+  title: Diff test
+  version: 2.0.0
+paths:
+  /pets:
+    get:
+      operationId: findPets
+      parameters:
+        - name: limit
+          in: query
+          required: true
+          schema:
+            type: integer
+            format: int32
+        - name: offset
+          in: query
+          schema:
+            type: string
+        - name: tags
+          in: query
+          schema:
+            type: array
+            items:
+              type: string
+      responses:
+        '200':
+          description: pets
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Pet'
+  /pets/{id}:
+    get:
+      operationId: showPet
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        '200':
+          description: pet
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [id, name]
+      properties:
+        id:
+          type: integer
+          format: int64
+        name:
+          type: string
+        tag:
+          type: string
+    PetKind:
+      type: string
+      enum: [cat, dog, fish]
+    Creature:
+      type: object
+      properties:
+        legs:
+          type: integer
+`
@@ -2,7 +2,9 @@ package codegen
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 )
 
 const (
@@ -12,6 +14,12 @@ const (
 	extPropOptionalValue = "x-go-optional-value"
 	extPropString        = "x-go-string"
 	extMiddlewares       = "x-go-middlewares"
+	extEnumVarNames      = "x-enum-varnames"
+	extEnumDescriptions  = "x-enum-descriptions"
+	extExtensibleEnum    = "x-extensible-enum"
+	extTimeout           = "x-go-timeout"
+	extMaxBodyBytes      = "x-go-max-body-bytes"
+	extGoName            = "x-go-name"
 )
 
 type extImportPathDetails struct {
@@ -51,6 +59,38 @@ func extParseMiddlewares(extPropValue interface{}) ([]string, error) {
 	return middlewares, err
 }
 
+func extParseTimeout(extPropValue interface{}) (time.Duration, error) {
+	var s string
+	if err := extParseAny(extPropValue, &s); err != nil {
+		return 0, err
+	}
+	timeout, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if timeout <= 0 {
+		return 0, errors.New("timeout must be positive")
+	}
+	return timeout, nil
+}
+
+func extParseMaxBodyBytes(extPropValue interface{}) (int64, error) {
+	var n int64
+	if err := extParseAny(extPropValue, &n); err != nil {
+		return 0, err
+	}
+	if n <= 0 {
+		return 0, errors.New("limit must be positive")
+	}
+	return n, nil
+}
+
+func extParseStrings(extPropValue interface{}) ([]string, error) {
+	var strs []string
+	err := extParseAny(extPropValue, &strs)
+	return strs, err
+}
+
 func extParseBool(extPropValue interface{}) (bool, error) {
 	var b bool
 	err := extParseAny(extPropValue, &b)
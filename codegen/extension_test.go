@@ -3,6 +3,7 @@ package codegen
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -48,3 +49,25 @@ func Test_extImportPath(t *testing.T) {
 		})
 	}
 }
+
+func Test_extParseTimeout(t *testing.T) {
+	timeout, err := extParseTimeout(json.RawMessage(`"1m30s"`))
+	assert.NoError(t, err)
+	assert.Equal(t, 90*time.Second, timeout)
+
+	for _, raw := range []string{`"forever"`, `"0s"`, `"-1s"`, `5`} {
+		_, err := extParseTimeout(json.RawMessage(raw))
+		assert.Error(t, err, raw)
+	}
+}
+
+func Test_extParseMaxBodyBytes(t *testing.T) {
+	n, err := extParseMaxBodyBytes(json.RawMessage(`1048576`))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1048576), n)
+
+	for _, raw := range []string{`"1MB"`, `0`, `-1`, `1.5`} {
+		_, err := extParseMaxBodyBytes(json.RawMessage(raw))
+		assert.Error(t, err, raw)
+	}
+}
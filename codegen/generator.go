@@ -0,0 +1,153 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"golang.org/x/tools/imports"
+)
+
+// Plugin hooks into code generation. Besides Name, a plugin implements any of
+// SchemaPlugin, OperationPlugin, TemplateFuncsPlugin and FilePlugin.
+type Plugin interface {
+	// Name identifies the plugin in errors.
+	Name() string
+}
+
+// SchemaPlugin is a Plugin which modifies the Go representation of schemas.
+type SchemaPlugin interface {
+	Plugin
+	// OnSchema is called with every schema converted to a Go type, except
+	// references to other types. path is the path used to name the type.
+	OnSchema(path []string, schema *openapi3.Schema, out *Schema) error
+}
+
+// OperationPlugin is a Plugin which modifies the description of operations.
+type OperationPlugin interface {
+	Plugin
+	// OnOperation is called with every operation, before any code is
+	// generated for it.
+	OnOperation(op *OperationDefinition) error
+}
+
+// TemplateFuncsPlugin is a Plugin which adds functions to the templates.
+// They replace built-in functions of the same name.
+type TemplateFuncsPlugin interface {
+	Plugin
+	TemplateFuncs() template.FuncMap
+}
+
+// FilePlugin is a Plugin which emits files besides the generated code.
+type FilePlugin interface {
+	Plugin
+	Files(ctx FileContext) ([]File, error)
+}
+
+// FileContext is the generation state passed to a FilePlugin.
+type FileContext struct {
+	PackageName string
+	Options     Options
+	Swagger     *openapi3.T
+	Operations  []OperationDefinition
+	// Templates are the templates of the generated code, including user
+	// templates and the functions of plugins. Plugins may add their own
+	// templates to it.
+	Templates *template.Template
+}
+
+// File is a file emitted by a FilePlugin. Go files are formatted like the
+// generated code.
+type File struct {
+	Name    string
+	Content string
+}
+
+// Generator generates code with a set of plugins.
+type Generator struct {
+	Options Options
+	plugins []Plugin
+}
+
+// NewGenerator returns a Generator generating code with opts and plugins.
+func NewGenerator(opts Options, plugins ...Plugin) *Generator {
+	return &Generator{Options: opts, plugins: plugins}
+}
+
+// Use registers plugins with g. Hooks are called in the order in which
+// plugins were registered.
+func (g *Generator) Use(plugins ...Plugin) {
+	g.plugins = append(g.plugins, plugins...)
+}
+
+// Generate generates the code for swagger, and the files emitted by plugins.
+// It is safe to call Generate concurrently for different specs.
+func (g *Generator) Generate(swagger *openapi3.T, packageName string) (string, []File, error) {
+	code, files, err := generate(swagger, packageName, g.Options, g.plugins)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// The generation code produces unindented horrors. Use the Go Imports
+	// to make it all pretty.
+	if g.Options.SkipFmt {
+		return code, files, nil
+	}
+
+	out, err := imports.Process(packageName+".go", []byte(code), nil)
+	if err != nil {
+		return "", nil, fmt.Errorf("error formatting Go code: %w", err)
+	}
+	for i, f := range files {
+		if !strings.HasSuffix(f.Name, ".go") {
+			continue
+		}
+		content, err := imports.Process(f.Name, []byte(f.Content), nil)
+		if err != nil {
+			return "", nil, fmt.Errorf("error formatting %s: %w", f.Name, err)
+		}
+		files[i].Content = string(content)
+	}
+	return string(out), files, nil
+}
+
+// activePlugins are the plugins of the running generation. They are guarded
+// by generateMu, like importMapping.
+var activePlugins []Plugin
+
+func schemaHook(path []string, schema *openapi3.Schema, out *Schema) error {
+	for _, p := range activePlugins {
+		if h, ok := p.(SchemaPlugin); ok {
+			if err := h.OnSchema(path, schema, out); err != nil {
+				return fmt.Errorf("plugin %s: %w", p.Name(), err)
+			}
+		}
+	}
+	return nil
+}
+
+func operationHook(op *OperationDefinition) error {
+	for _, p := range activePlugins {
+		if h, ok := p.(OperationPlugin); ok {
+			if err := h.OnOperation(op); err != nil {
+				return fmt.Errorf("plugin %s: %w", p.Name(), err)
+			}
+		}
+	}
+	return nil
+}
+
+func pluginFiles(ctx FileContext) ([]File, error) {
+	var files []File
+	for _, p := range activePlugins {
+		if h, ok := p.(FilePlugin); ok {
+			f, err := h.Files(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("plugin %s: %w", p.Name(), err)
+			}
+			files = append(files, f...)
+		}
+	}
+	return files, nil
+}
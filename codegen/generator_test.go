@@ -0,0 +1,136 @@
+package codegen
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// conventionsPlugin implements company conventions: string IDs use a custom
+// type, audited schemas get audit fields, and every operation is listed in an
+// extra file.
+type conventionsPlugin struct{}
+
+func (conventionsPlugin) Name() string { return "conventions" }
+
+func (conventionsPlugin) OnSchema(path []string, schema *openapi3.Schema, out *Schema) error {
+	if schema.Type == "string" && schema.Format == "id" {
+		out.GoType = "ids.ID"
+		out.CustomImports = append(out.CustomImports, `"example.com/ids"`)
+	}
+	if _, ok := schema.Extensions["x-audited"]; ok {
+		out.Properties = append(out.Properties, Property{
+			JSONFieldName: "createdAt",
+			Schema:        Schema{GoType: "time.Time"},
+			Required:      true,
+		})
+		out.GoType = GenStructFromSchema(*out)
+	}
+	return nil
+}
+
+func (conventionsPlugin) OnOperation(op *OperationDefinition) error {
+	op.Summary = "[" + op.Method + "] " + op.Summary
+	return nil
+}
+
+func (conventionsPlugin) TemplateFuncs() template.FuncMap {
+	return template.FuncMap{"shout": strings.ToUpper}
+}
+
+func (conventionsPlugin) Files(ctx FileContext) ([]File, error) {
+	t, err := ctx.Templates.New("operations.tmpl").Parse(`package {{.PackageName}}
+
+var Operations = []string{
+{{range .Operations}}	"{{shout .OperationID}}",
+{{end}}}
+`)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, ctx); err != nil {
+		return nil, err
+	}
+	return []File{{Name: "operations.gen.go", Content: buf.String()}}, nil
+}
+
+type failingPlugin struct{}
+
+func (failingPlugin) Name() string { return "failing" }
+
+func (failingPlugin) OnOperation(op *OperationDefinition) error {
+	return errors.New("no operations allowed")
+}
+
+func TestGenerator(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(generatorTestSpec))
+	require.NoError(t, err)
+
+	g := NewGenerator(Options{GenerateTypes: true, GenerateServer: true})
+	g.Use(conventionsPlugin{})
+	code, files, err := g.Generate(swagger, "api")
+	require.NoError(t, err)
+
+	assert.Contains(t, code, `"example.com/ids"`)
+	assert.Regexp(t, `ID\s+ids\.ID\s+`+"`json:\"id\"`", code)
+	assert.Regexp(t, `CreatedAt\s+time\.Time\s+`+"`json:\"createdAt\"`", code)
+	assert.Contains(t, code, "// [GET] Get a user")
+
+	require.Len(t, files, 1)
+	assert.Equal(t, "operations.gen.go", files[0].Name)
+	assert.Equal(t, "package api\n\nvar Operations = []string{\n\t\"GETUSER\",\n}\n", files[0].Content)
+}
+
+func TestGeneratorPluginError(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(generatorTestSpec))
+	require.NoError(t, err)
+
+	_, _, err = NewGenerator(Options{GenerateServer: true}, failingPlugin{}).Generate(swagger, "api")
+	assert.EqualError(t, err, "error creating operation definitions: error describing operation GetUser: plugin failing: no operations allowed")
+}
+
+const generatorTestSpec = `
+openapi: 3.0.1
+info:
+  title: Generator test
+  version: 1.0.0
+paths:
+  /users/{id}:
+    get:
+      operationId: getUser
+      summary: Get a user
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+            format: id
+      responses:
+        '200':
+          description: user
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/User'
+components:
+  schemas:
+    User:
+      type: object
+      x-audited: true
+      required: [id]
+      properties:
+        id:
+          type: string
+          format: id
+        name:
+          type: string
+`
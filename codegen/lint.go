@@ -0,0 +1,488 @@
+package codegen
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// LintSeverity classifies a LintProblem.
+type LintSeverity string
+
+// List of severities a LintProblem can have.
+const (
+	// LintError is used for problems which make code generation fail, or
+	// produce code that does not compile.
+	LintError LintSeverity = "error"
+	// LintWarning is used for constructs that are generated, but not in the
+	// way the spec author is likely to expect.
+	LintWarning LintSeverity = "warning"
+)
+
+// LintProblem describes a single problem found in a spec by Lint.
+type LintProblem struct {
+	Pointer  string       `json:"pointer"` // JSON pointer to the offending node, eg /components/schemas/Pet
+	Severity LintSeverity `json:"severity"`
+	Message  string       `json:"message"`
+}
+
+// String returns the problem in a "pointer: severity: message" format.
+func (p LintProblem) String() string {
+	pointer := p.Pointer
+	if pointer == "" {
+		pointer = "/"
+	}
+	return fmt.Sprintf("%s: %s: %s", pointer, p.Severity, p.Message)
+}
+
+// generatedIdentifiers are the package level identifiers emitted by the
+// built-in templates, which may not be reused by names derived from the spec.
+var generatedIdentifiers = []string{
+	"Response",
+	"ServerInterface",
+	"ServerInterfaceWrapper",
+	"ServerOptions",
+	"ServerOption",
+	"Middlewares",
+	"Handler",
+	"WithRouter",
+	"WithServerBaseURL",
+	"WithMiddlewares",
+	"WithErrorHandler",
+	"WithResponseErrorHandler",
+	"ResponseErrorHandler",
+	"HTTPError",
+	"WithSpecRoute",
+	"WithSpecUI",
+	"SpecHandler",
+	"ParameterError",
+	"ParameterErrors",
+	"UnescapedCookieParamError",
+	"UnmarshalingParamError",
+	"RequiredParamError",
+	"RequiredHeaderError",
+	"InvalidParamFormatError",
+	"TooManyValuesForParamError",
+	"PathToRawSpec",
+	"GetSwagger",
+}
+
+// Lint runs the code generation passes over swagger, and collects every
+// problem that would make generation fail or produce broken code, instead of
+// stopping at the first one. Problems are sorted by their JSON pointer.
+//
+// Lint modifies swagger in the same way Generate does.
+func Lint(swagger *openapi3.T, opts Options) []LintProblem {
+	generateMu.Lock()
+	defer generateMu.Unlock()
+
+	importMapping = constructImportMapping(opts.ImportMapping)
+	filterOperationsByTag(swagger, opts)
+
+	l := linter{
+		seen:  make(map[LintProblem]bool),
+		names: make(map[string][]string),
+	}
+	mappings, err := constructTypeMapping(opts.TypeMappings)
+	if err != nil {
+		l.report("", LintError, "%v", err)
+	}
+	typeMappings = mappings
+	defer func() { typeMappings = nil }()
+	for _, name := range generatedIdentifiers {
+		l.names[name] = []string{""}
+	}
+
+	l.lintComponents(&swagger.Components, opts.ExcludeSchemas)
+	l.lintOperations(swagger)
+	l.lintNames()
+
+	sort.SliceStable(l.problems, func(i, j int) bool {
+		return l.problems[i].Pointer < l.problems[j].Pointer
+	})
+	return l.problems
+}
+
+type linter struct {
+	problems []LintProblem
+	seen     map[LintProblem]bool
+
+	// names maps generated Go identifiers to the pointers they were derived
+	// from, so that collisions can be reported.
+	names map[string][]string
+}
+
+func (l *linter) report(pointer string, severity LintSeverity, format string, args ...interface{}) {
+	p := LintProblem{
+		Pointer:  pointer,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	}
+	if l.seen[p] {
+		return
+	}
+	l.seen[p] = true
+	l.problems = append(l.problems, p)
+}
+
+// declare records that the Go identifier name is generated for the node at
+// pointer.
+func (l *linter) declare(name, pointer string) {
+	if StringInArray(pointer, l.names[name]) {
+		return
+	}
+	l.names[name] = append(l.names[name], pointer)
+}
+
+func (l *linter) lintNames() {
+	names := make([]string, 0, len(l.names))
+	for name := range l.names {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		pointers := l.names[name]
+		if len(pointers) < 2 {
+			continue
+		}
+		for _, pointer := range pointers[1:] {
+			other := pointers[0]
+			if other == "" {
+				l.report(pointer, LintError, "generated name %s collides with an identifier of the generated code", name)
+				continue
+			}
+			l.report(pointer, LintError, "generated name %s collides with the one generated for %s", name, other)
+		}
+	}
+}
+
+func (l *linter) lintComponents(components *openapi3.Components, excludeSchemas []string) {
+	for _, name := range SortedSchemaKeys(components.Schemas) {
+		if StringInArray(name, excludeSchemas) {
+			continue
+		}
+		pointer := jsonPointer("components", "schemas", name)
+		l.declare(SchemaNameToTypeName(name), pointer)
+		if schema, ok := l.lintSchema(components.Schemas[name], pointer, []string{name}); ok {
+			l.declareSchema(schema, pointer)
+		}
+	}
+
+	for _, name := range SortedParameterKeys(components.Parameters) {
+		paramRef := components.Parameters[name]
+		pointer := jsonPointer("components", "parameters", name)
+		if paramRef.Ref != "" {
+			continue
+		}
+		if l.lintParameter(paramRef.Value, pointer, []string{name}) {
+			l.declare(SchemaNameToTypeName(name), pointer)
+		}
+	}
+
+	for _, name := range SortedResponsesKeys(components.Responses) {
+		responseRef := components.Responses[name]
+		pointer := jsonPointer("components", "responses", name)
+		if responseRef.Ref != "" || responseRef.Value == nil {
+			continue
+		}
+		l.lintResponse(responseRef, pointer, name)
+		if content, ok := responseRef.Value.Content["application/json"]; ok {
+			if _, err := GenerateGoSchema(content.Schema, []string{name}); err == nil {
+				l.declare(SchemaNameToTypeName(name), pointer)
+			}
+		}
+	}
+
+	for _, name := range SortedRequestBodyKeys(components.RequestBodies) {
+		bodyRef := components.RequestBodies[name]
+		pointer := jsonPointer("components", "requestBodies", name)
+		if bodyRef.Ref != "" || bodyRef.Value == nil {
+			continue
+		}
+		l.lintRequestBody(bodyRef.Value, pointer, func(contentType string) string {
+			if contentType != "application/json" {
+				return ""
+			}
+			return name
+		})
+		if content, ok := bodyRef.Value.Content["application/json"]; ok {
+			if _, err := GenerateGoSchema(content.Schema, []string{name}); err == nil {
+				l.declare(SchemaNameToTypeName(name), pointer)
+			}
+		}
+	}
+}
+
+func (l *linter) lintOperations(swagger *openapi3.T) {
+	operationIDs := make(map[string]string)
+
+	for _, requestPath := range SortedPathsKeys(swagger.Paths) {
+		pathItem := swagger.Paths[requestPath]
+		pathPointer := jsonPointer("paths", requestPath)
+
+		for i, paramRef := range pathItem.Parameters {
+			if paramRef.Ref != "" || paramRef.Value == nil {
+				continue
+			}
+			l.lintParameter(paramRef.Value, pathPointer+jsonPointer("parameters", strconv.Itoa(i)), []string{paramRef.Value.Name})
+		}
+
+		pathOps := pathItem.Operations()
+		for _, method := range SortedOperationsKeys(pathOps) {
+			op := pathOps[method]
+			pointer := pathPointer + jsonPointer(strings.ToLower(method))
+
+			if op.OperationID == "" {
+				l.report(pointer, LintWarning, "missing operationId, generated names will change when the path changes")
+			}
+
+			before := len(l.problems)
+			opID := ToCamelCase(op.OperationID)
+			for i, paramRef := range op.Parameters {
+				if paramRef.Ref != "" || paramRef.Value == nil {
+					continue
+				}
+				l.lintParameter(paramRef.Value, pointer+jsonPointer("parameters", strconv.Itoa(i)), []string{opID + "Params", paramRef.Value.Name})
+			}
+			if op.RequestBody != nil && op.RequestBody.Ref == "" && op.RequestBody.Value != nil {
+				l.lintRequestBody(op.RequestBody.Value, pointer+jsonPointer("requestBody"), func(contentType string) string {
+					if tag, _ := requestBodyTag(contentType); tag != "" {
+						return opID + tag + "Body"
+					}
+					return ""
+				})
+			}
+			for _, responseName := range SortedResponsesKeys(op.Responses) {
+				l.lintResponse(op.Responses[responseName], pointer+jsonPointer("responses", responseName), responseName)
+			}
+			// Only report the errors that only surface once the operation is
+			// described as a whole if nothing more specific was found.
+			schemaErrors := l.countErrors(before)
+
+			// Describe the operation on its own, so that one broken operation
+			// does not hide the problems of the others.
+			item := &openapi3.PathItem{
+				ExtensionProps: pathItem.ExtensionProps,
+				Parameters:     pathItem.Parameters,
+				Servers:        pathItem.Servers,
+			}
+			item.SetOperation(method, op)
+			ops, err := OperationDefinitions(&openapi3.T{
+				ExtensionProps: swagger.ExtensionProps,
+				Paths:          openapi3.Paths{requestPath: item},
+				Security:       swagger.Security,
+				Tags:           swagger.Tags,
+			})
+			if err != nil {
+				if schemaErrors == 0 {
+					l.report(pointer, LintError, "%v", err)
+				}
+				continue
+			}
+
+			for _, opDef := range ops {
+				if other, ok := operationIDs[opDef.OperationID]; ok {
+					l.report(pointer, LintError, "operationId %s is also used by %s", opDef.OperationID, other)
+				} else {
+					operationIDs[opDef.OperationID] = pointer
+				}
+
+				for _, td := range opDef.TypeDefinitions {
+					l.declare(td.TypeName, pointer)
+				}
+				tds, err := opDef.GetResponseTypeDefinitions()
+				if err != nil {
+					if schemaErrors == 0 {
+						l.report(pointer+jsonPointer("responses"), LintError, "%v", err)
+					}
+					continue
+				}
+				for _, td := range tds {
+					l.declare(opDef.OperationID+TitleWord(td.TypeName)+"Response", pointer)
+				}
+				nrds, _ := opDef.GetNegotiatedResponseDefinitions()
+				for _, nrd := range nrds {
+					l.declare(opDef.OperationID+TitleWord(nrd.TypeName)+"Response", pointer)
+				}
+			}
+		}
+	}
+}
+
+func (l *linter) countErrors(since int) int {
+	var n int
+	for _, p := range l.problems[since:] {
+		if p.Severity == LintError {
+			n++
+		}
+	}
+	return n
+}
+
+func (l *linter) lintParameter(param *openapi3.Parameter, pointer string, path []string) bool {
+	if param.Schema == nil && param.Content == nil {
+		l.report(pointer, LintError, "parameter %s has no schema or content", param.Name)
+		return false
+	}
+
+	switch style := param.Style; style {
+	case "", "simple", "label", "matrix", "form", "spaceDelimited", "pipeDelimited", "deepObject":
+	default:
+		l.report(pointer+jsonPointer("style"), LintError, "unknown parameter style %s", style)
+	}
+
+	if param.Schema != nil {
+		_, ok := l.lintSchema(param.Schema, pointer+jsonPointer("schema"), path)
+		return ok
+	}
+
+	// Parameters with any other content than application/json alone are
+	// passed through as strings.
+	content, found := param.Content["application/json"]
+	if !found || len(param.Content) > 1 {
+		return true
+	}
+	_, ok := l.lintSchema(content.Schema, pointer+jsonPointer("content", "application/json", "schema"), path)
+	return ok
+}
+
+// lintRequestBody lints the content of body. typeName returns the name of the
+// type generated for a content type, or "" if none is.
+func (l *linter) lintRequestBody(body *openapi3.RequestBody, pointer string, typeName func(contentType string) string) {
+	for _, contentType := range SortedContentKeys(body.Content) {
+		contentPointer := pointer + jsonPointer("content", contentType)
+		name := typeName(contentType)
+		if name == "" {
+			l.report(contentPointer, LintWarning, "no type is generated for request bodies of type %s", contentType)
+			continue
+		}
+		if schema := body.Content[contentType].Schema; schema != nil {
+			l.lintSchema(schema, contentPointer+jsonPointer("schema"), []string{name})
+		}
+	}
+}
+
+func (l *linter) lintResponse(responseRef *openapi3.ResponseRef, pointer string, name string) {
+	if responseRef.Ref != "" || responseRef.Value == nil {
+		return
+	}
+	for _, contentType := range SortedContentKeys(responseRef.Value.Content) {
+		content := responseRef.Value.Content[contentType]
+		contentPointer := pointer + jsonPointer("content", contentType)
+		// Other content types are written raw, so no type is generated for
+		// their schema.
+		if content.Schema == nil ||
+			!StringInArray(contentType, contentTypesJSON) &&
+				!StringInArray(contentType, contentTypesYAML) &&
+				!StringInArray(contentType, contentTypesXML) {
+			continue
+		}
+		l.lintSchema(content.Schema, contentPointer+jsonPointer("schema"), []string{name})
+	}
+}
+
+// lintSchema converts sref to a Go schema. If that fails, the error is
+// attributed to the most specific inline sub schema which fails on its own.
+func (l *linter) lintSchema(sref *openapi3.SchemaRef, pointer string, path []string) (Schema, bool) {
+	walkInlineSchema(sref, pointer, func(sref *openapi3.SchemaRef, pointer string) {
+		if sref.Value.AnyOf != nil || sref.Value.OneOf != nil {
+			l.report(pointer, LintWarning, "anyOf and oneOf are generated as interface{}")
+		}
+		if sref.Value.Not != nil {
+			l.report(pointer+jsonPointer("not"), LintWarning, "not is ignored by the generator")
+		}
+	})
+
+	schema, err := GenerateGoSchema(sref, path)
+	if err == nil {
+		return schema, true
+	}
+
+	before := len(l.problems)
+	if sref != nil && sref.Ref == "" && sref.Value != nil {
+		s := sref.Value
+		for _, name := range SortedSchemaKeys(s.Properties) {
+			l.lintSchema(s.Properties[name], pointer+jsonPointer("properties", name), append(path, name))
+		}
+		for i, ref := range s.AllOf {
+			l.lintSchema(ref, pointer+jsonPointer("allOf", strconv.Itoa(i)), path)
+		}
+		if s.Items != nil {
+			l.lintSchema(s.Items, pointer+jsonPointer("items"), path)
+		}
+		if s.AdditionalProperties != nil {
+			l.lintSchema(s.AdditionalProperties, pointer+jsonPointer("additionalProperties"), path)
+		}
+	}
+	if l.countErrors(before) == 0 {
+		l.report(pointer, LintError, "%v", unwrapAll(err))
+	}
+	return Schema{}, false
+}
+
+// declareSchema declares all the additional types and enum values generated
+// for schema.
+func (l *linter) declareSchema(schema Schema, pointer string) {
+	for name := range schema.EnumValues {
+		l.declare(name, pointer)
+	}
+	for _, td := range schema.AdditionalTypeDefs() {
+		l.declare(td.TypeName, pointer)
+		for name := range td.Schema.EnumValues {
+			l.declare(name, pointer)
+		}
+	}
+}
+
+// walkInlineSchema calls fn for sref and all of its sub schemas which are not
+// references.
+func walkInlineSchema(sref *openapi3.SchemaRef, pointer string, fn func(*openapi3.SchemaRef, string)) {
+	if sref == nil || sref.Ref != "" || sref.Value == nil {
+		return
+	}
+	fn(sref, pointer)
+
+	s := sref.Value
+	for _, name := range SortedSchemaKeys(s.Properties) {
+		walkInlineSchema(s.Properties[name], pointer+jsonPointer("properties", name), fn)
+	}
+	for i, ref := range s.AllOf {
+		walkInlineSchema(ref, pointer+jsonPointer("allOf", strconv.Itoa(i)), fn)
+	}
+	for i, ref := range s.AnyOf {
+		walkInlineSchema(ref, pointer+jsonPointer("anyOf", strconv.Itoa(i)), fn)
+	}
+	for i, ref := range s.OneOf {
+		walkInlineSchema(ref, pointer+jsonPointer("oneOf", strconv.Itoa(i)), fn)
+	}
+	walkInlineSchema(s.Items, pointer+jsonPointer("items"), fn)
+	walkInlineSchema(s.AdditionalProperties, pointer+jsonPointer("additionalProperties"), fn)
+}
+
+// jsonPointer escapes and joins tokens into a JSON pointer, following
+// RFC 6901. The result can be appended to another pointer.
+func jsonPointer(tokens ...string) string {
+	var b strings.Builder
+	for _, token := range tokens {
+		token = strings.ReplaceAll(token, "~", "~0")
+		token = strings.ReplaceAll(token, "/", "~1")
+		b.WriteString("/" + token)
+	}
+	return b.String()
+}
+
+// unwrapAll strips the context added by the nested schema generation calls,
+// since the pointer of the problem already locates it.
+func unwrapAll(err error) error {
+	for {
+		u, ok := err.(interface{ Unwrap() error })
+		if !ok || u.Unwrap() == nil {
+			return err
+		}
+		err = u.Unwrap()
+	}
+}
@@ -0,0 +1,145 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLint(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(lintTestSpec))
+	require.NoError(t, err)
+
+	problems := Lint(swagger, Options{})
+	assert.Equal(t, []LintProblem{
+		{
+			Pointer:  "/components/schemas/Pet/properties/age",
+			Severity: LintError,
+			Message:  "invalid integer format: int128",
+		},
+		{
+			Pointer:  "/components/schemas/Pet/properties/kind",
+			Severity: LintWarning,
+			Message:  "anyOf and oneOf are generated as interface{}",
+		},
+		{
+			Pointer:  "/components/schemas/Response",
+			Severity: LintError,
+			Message:  "generated name Response collides with an identifier of the generated code",
+		},
+		{
+			Pointer:  "/components/schemas/pet",
+			Severity: LintError,
+			Message:  "generated name Pet collides with the one generated for /components/schemas/Pet",
+		},
+		{
+			Pointer:  "/paths/~1pets/get",
+			Severity: LintWarning,
+			Message:  "missing operationId, generated names will change when the path changes",
+		},
+		{
+			Pointer:  "/paths/~1pets/get/parameters/0/schema",
+			Severity: LintError,
+			Message:  "unhandled Schema type: decimal",
+		},
+		{
+			Pointer:  "/paths/~1pets~1{id}/get",
+			Severity: LintError,
+			Message:  "generated name FindPetParams collides with the one generated for /components/schemas/FindPetParams",
+		},
+		{
+			Pointer:  "/paths/~1pets~1{id}/post",
+			Severity: LintError,
+			Message:  "operationId FindPet is also used by /paths/~1pets~1{id}/get",
+		},
+		{
+			Pointer:  "/paths/~1pets~1{id}~1status/put",
+			Severity: LintError,
+			Message:  "path '/pets/{id}/status' has 1 positional parameters, but spec has 0 declared",
+		},
+	}, problems)
+}
+
+func TestLintValidSpec(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(testOpenAPIDefinition))
+	require.NoError(t, err)
+
+	for _, p := range Lint(swagger, Options{}) {
+		assert.NotEqual(t, LintError, p.Severity, p.String())
+	}
+}
+
+func TestJSONPointer(t *testing.T) {
+	assert.Equal(t, "", jsonPointer())
+	assert.Equal(t, "/paths/~1pets~1{id}/get", jsonPointer("paths", "/pets/{id}", "get"))
+	assert.Equal(t, "/components/schemas/a~0b", jsonPointer("components", "schemas", "a~b"))
+}
+
+const lintTestSpec = `
+openapi: 3.0.1
+info:
+  title: Lint test
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      parameters:
+      - name: limit
+        in: query
+        schema:
+          type: decimal
+      responses:
+        200:
+          description: ok
+          content:
+            text/csv:
+              schema:
+                type: string
+  /pets/{id}:
+    parameters:
+    - name: id
+      in: path
+      required: true
+      schema:
+        type: integer
+    get:
+      operationId: find-pet
+      parameters:
+      - name: limit
+        in: query
+        schema:
+          type: integer
+      responses:
+        200:
+          description: ok
+    post:
+      operationId: findPet
+      responses:
+        200:
+          description: ok
+  /pets/{id}/status:
+    put:
+      operationId: setStatus
+      responses:
+        200:
+          description: ok
+components:
+  schemas:
+    Pet:
+      properties:
+        age:
+          type: integer
+          format: int128
+        kind:
+          oneOf:
+          - type: string
+          - type: integer
+    pet:
+      type: string
+    Response:
+      type: string
+    FindPetParams:
+      type: string
+`
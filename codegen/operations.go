@@ -18,8 +18,11 @@ import (
 	"bufio"
 	"bytes"
 	"fmt"
+	"mime"
+	"sort"
 	"strings"
 	"text/template"
+	"time"
 	"unicode"
 
 	"github.com/kenshaw/snaker"
@@ -115,6 +118,17 @@ func (pd *ParameterDefinition) Explode() bool {
 	return *pd.Spec.Explode
 }
 
+// SplitsRawQuery returns if pd is an unexploded spaceDelimited or pipeDelimited
+// query parameter, whose values are split from the raw query before they are
+// unescaped, so that escaped delimiters stay within them.
+func (pd *ParameterDefinition) SplitsRawQuery() bool {
+	if pd.Spec.In != "query" || pd.Explode() {
+		return false
+	}
+	style := pd.Style()
+	return style == "spaceDelimited" || style == "pipeDelimited"
+}
+
 // GoVariableName returns a safe version of the name of pd's GoName.
 func (pd ParameterDefinition) GoVariableName() string {
 	name := snaker.ForceLowerCamelIdentifier(pd.GoName())
@@ -137,6 +151,70 @@ func (pd ParameterDefinition) IndirectOptional() bool {
 	return !pd.Required && !pd.Schema.SkipOptionalPointer
 }
 
+// primitiveParsers maps the Go types of primitive parameters to the runtime
+// functions parsing them. Strings need no parsing.
+var primitiveParsers = map[string]string{
+	"string":  "",
+	"int":     "ParseInt",
+	"int8":    "ParseInt8",
+	"int16":   "ParseInt16",
+	"int32":   "ParseInt32",
+	"int64":   "ParseInt64",
+	"uint":    "ParseUint",
+	"uint8":   "ParseUint8",
+	"uint16":  "ParseUint16",
+	"uint32":  "ParseUint32",
+	"uint64":  "ParseUint64",
+	"float32": "ParseFloat32",
+	"float64": "ParseFloat64",
+	"bool":    "ParseBool",
+}
+
+// IsPrimitive returns if pd is styled, and of a primitive type or an array of
+// a primitive type, which are bound without reflection. Exploded objects and
+// deepObject parameters are not primitive.
+func (pd ParameterDefinition) IsPrimitive() bool {
+	if !pd.IsStyled() || pd.Style() == "deepObject" {
+		return false
+	}
+	s := pd.Schema
+	if pd.IsArray() {
+		s = *s.ArrayType
+	}
+	_, ok := primitiveParsers[s.TypeDecl()]
+	return ok && !s.IsRef()
+}
+
+// IsArray returns if pd is an array which isn't a named type.
+func (pd ParameterDefinition) IsArray() bool {
+	return pd.Schema.ArrayType != nil && !pd.Schema.IsRef()
+}
+
+// IsArrayOrObject returns if the schema of pd is an array or an object, whose
+// values are lists.
+func (pd ParameterDefinition) IsArrayOrObject() bool {
+	if pd.Spec.Schema == nil || pd.Spec.Schema.Value == nil {
+		return false
+	}
+	s := pd.Spec.Schema.Value
+	return s.Type == "array" || s.Type == "object" || (s.Type == "" && len(s.Properties) > 0)
+}
+
+// PrimitiveParser returns the runtime function parsing the values of pd,
+// such as ParseInt32 or ParseInt32Slice, if it IsPrimitive. It returns "" for
+// strings, whose values need no parsing.
+func (pd ParameterDefinition) PrimitiveParser() string {
+	s := pd.Schema
+	if pd.IsArray() {
+		s = *s.ArrayType
+	}
+	parser := primitiveParsers[s.TypeDecl()]
+	if parser != "" && pd.IsArray() {
+		parser += "Slice"
+	}
+	return parser
+}
+
 // ParameterDefinitions is a slice of ParameterDefinition.
 type ParameterDefinitions []ParameterDefinition
 
@@ -224,6 +302,9 @@ type OperationDefinition struct {
 	Method              string                  // GET, POST, DELETE, etc.
 	Path                string                  // The Swagger path for the operation, like /resource/{id}
 	Middlewares         []string                // Sent as part of x-go-middlewares.
+	Timeout             time.Duration           // Sent as x-go-timeout, 0 if none.
+	MaxBodyBytes        int64                   // Sent as x-go-max-body-bytes, 0 if none.
+	ServerBasePath      string                  // Base path of the servers of the operation, if it differs from the spec's.
 	Spec                *openapi3.Operation
 }
 
@@ -251,6 +332,17 @@ func (o *OperationDefinition) RequiresParamObject() bool {
 	return len(o.Params()) > 0
 }
 
+// DecodesQuery returns if a query parameter of o is bound from the decoded
+// query of the request, rather than from its raw query.
+func (o *OperationDefinition) DecodesQuery() bool {
+	for _, pd := range o.QueryParams {
+		if !pd.SplitsRawQuery() {
+			return true
+		}
+	}
+	return false
+}
+
 // SummaryAsComment returns the summary as a multiline comment for o.
 func (o *OperationDefinition) SummaryAsComment() string {
 	if o.Summary == "" {
@@ -264,10 +356,31 @@ func (o *OperationDefinition) SummaryAsComment() string {
 	return strings.Join(parts, "\n")
 }
 
+// TimeoutDecl returns the timeout of o as a Go expression, eg,
+// "1500 * time.Millisecond".
+func (o *OperationDefinition) TimeoutDecl() string {
+	for _, unit := range []struct {
+		d    time.Duration
+		name string
+	}{
+		{time.Hour, "time.Hour"},
+		{time.Minute, "time.Minute"},
+		{time.Second, "time.Second"},
+		{time.Millisecond, "time.Millisecond"},
+		{time.Microsecond, "time.Microsecond"},
+	} {
+		if o.Timeout%unit.d == 0 {
+			return fmt.Sprintf("%d * %s", o.Timeout/unit.d, unit.name)
+		}
+	}
+	return fmt.Sprintf("%d * time.Nanosecond", o.Timeout)
+}
+
 // GetResponseTypeDefinitions produces a list of type definitions for a given
 // Operation for the response types which we know how to parse. These will be
 // turned into fields on a response object for automatic deserialization of
-// responses.
+// responses. Media types other than JSON, YAML and XML produce raw
+// definitions, whose body is written as is.
 func (o *OperationDefinition) GetResponseTypeDefinitions() ([]ResponseTypeDefinition, error) {
 	var tds []ResponseTypeDefinition
 
@@ -281,6 +394,22 @@ func (o *OperationDefinition) GetResponseTypeDefinitions() ([]ResponseTypeDefini
 			sortedContentKeys := SortedContentKeys(responseRef.Value.Content)
 			for _, contentTypeName := range sortedContentKeys {
 				contentType := responseRef.Value.Content[contentTypeName]
+
+				var tag string
+				switch {
+				case StringInArray(contentTypeName, contentTypesJSON):
+					tag = "JSON"
+				// YAML:
+				case StringInArray(contentTypeName, contentTypesYAML):
+					tag = "YAML"
+				// XML:
+				case StringInArray(contentTypeName, contentTypesXML):
+					tag = "XML"
+				default:
+					tds = append(tds, rawResponseTypeDefinition(responseName, contentTypeName, contentType.Schema))
+					continue
+				}
+
 				// We can only generate a type if we have a schema:
 				if contentType.Schema != nil {
 					responseSchema, err := GenerateGoSchema(contentType.Schema, []string{responseName})
@@ -288,23 +417,9 @@ func (o *OperationDefinition) GetResponseTypeDefinitions() ([]ResponseTypeDefini
 						return nil, fmt.Errorf("unable to determine Go type for %s.%s: %w", o.OperationID, contentTypeName, err)
 					}
 
-					var typeName string
-					switch {
-					case StringInArray(contentTypeName, contentTypesJSON):
-						typeName = fmt.Sprintf("JSON%s", ToCamelCase(responseName))
-					// YAML:
-					case StringInArray(contentTypeName, contentTypesYAML):
-						typeName = fmt.Sprintf("YAML%s", ToCamelCase(responseName))
-					// XML:
-					case StringInArray(contentTypeName, contentTypesXML):
-						typeName = fmt.Sprintf("XML%s", ToCamelCase(responseName))
-					default:
-						continue
-					}
-
 					td := ResponseTypeDefinition{
 						TypeDefinition: TypeDefinition{
-							TypeName: typeName,
+							TypeName: tag + ToCamelCase(responseName),
 							Schema:   responseSchema,
 						},
 						ResponseName:    responseName,
@@ -325,6 +440,189 @@ func (o *OperationDefinition) GetResponseTypeDefinitions() ([]ResponseTypeDefini
 	return tds, nil
 }
 
+// NegotiatedResponseDefinition describes a response which is declared with
+// several representations of the same body. The representation is chosen from
+// the Accept header of the request.
+type NegotiatedResponseDefinition struct {
+	// The name used in the constructor of the response, eg, 200 or Default.
+	TypeName string
+
+	// The type name of a response model, eg, 200 or default.
+	ResponseName string
+
+	// The body of the response, shared by all its representations.
+	Schema Schema
+
+	// The content types of the representations, the default one first.
+	ContentTypeNames []string
+}
+
+// GetNegotiatedResponseDefinitions returns the responses of the Operation
+// which have several JSON, YAML or XML representations with the same body
+// type. JSON is the default representation, if there is one.
+func (o *OperationDefinition) GetNegotiatedResponseDefinitions() ([]NegotiatedResponseDefinition, error) {
+	tds, err := o.GetResponseTypeDefinitions()
+	if err != nil {
+		return nil, err
+	}
+
+	var nrds []NegotiatedResponseDefinition
+	for i := 0; i < len(tds); {
+		// Response type definitions are grouped by response name.
+		var structured []ResponseTypeDefinition
+		j := i
+		for ; j < len(tds) && tds[j].ResponseName == tds[i].ResponseName; j++ {
+			if !tds[j].Raw {
+				structured = append(structured, tds[j])
+			}
+		}
+		i = j
+		if len(structured) < 2 {
+			continue
+		}
+
+		var contentTypes []string
+		sameBody := true
+		for _, td := range structured {
+			contentTypes = append(contentTypes, td.ContentTypeName)
+			sameBody = sameBody && td.Schema.TypeDecl() == structured[0].Schema.TypeDecl()
+		}
+		if !sameBody {
+			continue
+		}
+
+		// Content types are sorted, so this moves the first JSON one first.
+		sort.SliceStable(contentTypes, func(a, b int) bool {
+			return StringInArray(contentTypes[a], contentTypesJSON) && !StringInArray(contentTypes[b], contentTypesJSON)
+		})
+		nrds = append(nrds, NegotiatedResponseDefinition{
+			TypeName:         ToCamelCase(structured[0].ResponseName),
+			ResponseName:     structured[0].ResponseName,
+			Schema:           structured[0].Schema,
+			ContentTypeNames: contentTypes,
+		})
+	}
+	return nrds, nil
+}
+
+// ErrorResponseDefinition describes a response of an operation for a 4XX or
+// 5XX status code, a range of them, or the default response.
+type ErrorResponseDefinition struct {
+	// The type name of a response model, eg, 404, 5XX or default.
+	ResponseName string
+
+	// The Go condition on the status code, code, for which the response is
+	// declared. It is empty for the default response.
+	Condition string
+
+	// The bodies the response may be constructed with. There are none if the
+	// response has no content.
+	Bodies []ErrorResponseBody
+}
+
+// ErrorResponseBody describes a body an error response may be constructed
+// with.
+type ErrorResponseBody struct {
+	// The name of the constructor of the response, eg, FindPetsJSONDefaultResponse.
+	Constructor string
+
+	// The Go type of the body.
+	TypeDecl string
+}
+
+// GetErrorResponseDefinitions returns the error responses of the Operation,
+// in the order they match a status code: exact status codes first, then
+// ranges, then the default response.
+func (o *OperationDefinition) GetErrorResponseDefinitions() ([]ErrorResponseDefinition, error) {
+	tds, err := o.GetResponseTypeDefinitions()
+	if err != nil {
+		return nil, err
+	}
+	nrds, err := o.GetNegotiatedResponseDefinitions()
+	if err != nil {
+		return nil, err
+	}
+
+	// Sorted keys list exact status codes before their range, and default last.
+	var erds []ErrorResponseDefinition
+	for _, responseName := range SortedResponsesKeys(o.Spec.Responses) {
+		var condition string
+		switch name := strings.ToUpper(responseName); {
+		case name == "DEFAULT":
+		case name == "4XX" || name == "5XX":
+			condition = fmt.Sprintf("code/100 == %s", name[:1])
+		case strings.HasPrefix(name, "4") || strings.HasPrefix(name, "5"):
+			condition = "code == " + name
+		default:
+			continue
+		}
+
+		// A negotiated response is preferred to the constructors of its
+		// representations, and bodies of a type already listed are skipped.
+		erd := ErrorResponseDefinition{ResponseName: responseName, Condition: condition}
+		declared := make(map[string]bool)
+		addBody := func(typeName, typeDecl string) {
+			if declared[typeDecl] {
+				return
+			}
+			declared[typeDecl] = true
+			erd.Bodies = append(erd.Bodies, ErrorResponseBody{
+				Constructor: snaker.ForceCamelIdentifier(o.OperationID) + TitleWord(typeName) + "Response",
+				TypeDecl:    typeDecl,
+			})
+		}
+		for _, nrd := range nrds {
+			if nrd.ResponseName == responseName {
+				addBody(nrd.TypeName, nrd.Schema.TypeDecl())
+			}
+		}
+		for _, td := range tds {
+			if td.ResponseName == responseName {
+				addBody(td.TypeName, td.Schema.TypeDecl())
+			}
+		}
+		erds = append(erds, erd)
+	}
+	return erds, nil
+}
+
+// rawResponseTypeDefinition returns the definition of a response whose body
+// is written as is. The body is an io.Reader for binary content, a []byte for
+// base64 encoded or non-string content, and a string otherwise.
+func rawResponseTypeDefinition(responseName, contentTypeName string, sref *openapi3.SchemaRef) ResponseTypeDefinition {
+	mediaType, _, err := mime.ParseMediaType(contentTypeName)
+	if err != nil {
+		mediaType = contentTypeName
+	}
+
+	var goType string
+	switch {
+	case sref == nil || sref.Value == nil:
+		goType = "io.Reader"
+		if strings.HasPrefix(mediaType, "text/") {
+			goType = "string"
+		}
+	case sref.Value.Type != "string":
+		goType = "[]byte"
+	case sref.Value.Format == "binary":
+		goType = "io.Reader"
+	case sref.Value.Format == "byte":
+		goType = "[]byte"
+	default:
+		goType = "string"
+	}
+
+	return ResponseTypeDefinition{
+		TypeDefinition: TypeDefinition{
+			TypeName: SchemaNameToTypeName(strings.ReplaceAll(mediaType, "*", "any")) + ToCamelCase(responseName),
+			Schema:   Schema{GoType: goType},
+		},
+		ResponseName:    responseName,
+		ContentTypeName: contentTypeName,
+		Raw:             true,
+	}
+}
+
 // RequestBodyDefinition describes a request body
 type RequestBodyDefinition struct {
 	Required bool
@@ -366,6 +664,22 @@ func FilterParameterDefinitionByType(params []ParameterDefinition, in string) []
 func OperationDefinitions(swagger *openapi3.T) ([]OperationDefinition, error) {
 	var operations []OperationDefinition
 
+	rootMiddlewares, err := extMiddlewaresOf(swagger.Extensions)
+	if err != nil {
+		return nil, err
+	}
+	rootBasePath, err := serverBasePath(swagger.Servers)
+	if err != nil {
+		return nil, err
+	}
+
+	tagMiddlewares := make(map[string][]string)
+	for _, tag := range swagger.Tags {
+		if tagMiddlewares[tag.Name], err = extMiddlewaresOf(tag.Extensions); err != nil {
+			return nil, fmt.Errorf("tag %s: %w", tag.Name, err)
+		}
+	}
+
 	for _, requestPath := range SortedPathsKeys(swagger.Paths) {
 		pathItem := swagger.Paths[requestPath]
 		// These are parameters defined for all methods on a given path. They
@@ -376,20 +690,21 @@ func OperationDefinitions(swagger *openapi3.T) ([]OperationDefinition, error) {
 				requestPath, err)
 		}
 
-		var pathMiddlewares []string
-		if extension, ok := pathItem.Extensions[extMiddlewares]; ok {
-			var err error
-			pathMiddlewares, err = extParseMiddlewares(extension)
-			if err != nil {
-				return nil, fmt.Errorf("invalid value for %q: %w", extMiddlewares, err)
-			}
+		pathMiddlewares, err := extMiddlewaresOf(pathItem.Extensions)
+		if err != nil {
+			return nil, err
+		}
+
+		pathTimeout, pathMaxBodyBytes, err := extLimits(pathItem.Extensions, 0, 0)
+		if err != nil {
+			return nil, err
 		}
 
 		// Each path can have a number of operations, POST, GET, OPTIONS, etc.
 		pathOps := pathItem.Operations()
 		for _, opName := range SortedOperationsKeys(pathOps) {
 			op := pathOps[opName]
-			if pathItem.Servers != nil {
+			if op.Servers == nil && len(pathItem.Servers) > 0 {
 				op.Servers = &pathItem.Servers
 			}
 
@@ -423,13 +738,34 @@ func OperationDefinitions(swagger *openapi3.T) ([]OperationDefinition, error) {
 				return nil, err
 			}
 
-			middlewares := pathMiddlewares
-			if extension, ok := op.Extensions[extMiddlewares]; ok {
-				opMiddlewares, err := extParseMiddlewares(extension)
-				if err != nil {
-					return nil, fmt.Errorf("invalid value for %q: %w", extMiddlewares, err)
+			opMiddlewares, err := extMiddlewaresOf(op.Extensions)
+			if err != nil {
+				return nil, err
+			}
+			// Middlewares are chained from the document to the operation,
+			// through its tags and path.
+			chain := [][]string{rootMiddlewares}
+			for _, tag := range op.Tags {
+				chain = append(chain, tagMiddlewares[tag])
+			}
+			chain = append(chain, pathMiddlewares, opMiddlewares)
+			middlewares := middlewareChain(chain...)
+
+			timeout, maxBodyBytes, err := extLimits(op.Extensions, pathTimeout, pathMaxBodyBytes)
+			if err != nil {
+				return nil, err
+			}
+
+			// Operations are served under the base path of their own
+			// servers, if any.
+			var basePath string
+			if op.Servers != nil && len(*op.Servers) > 0 {
+				if basePath, err = serverBasePath(*op.Servers); err != nil {
+					return nil, err
+				}
+				if basePath == rootBasePath {
+					basePath = ""
 				}
-				middlewares = append(middlewares, opMiddlewares...)
 			}
 
 			bodyDefinitions, typeDefinitions, err := GenerateBodyDefinitions(op.OperationID, op.RequestBody)
@@ -457,6 +793,9 @@ func OperationDefinitions(swagger *openapi3.T) ([]OperationDefinition, error) {
 				Bodies:          bodyDefinitions,
 				TypeDefinitions: typeDefinitions,
 				Middlewares:     middlewares,
+				Timeout:         timeout,
+				MaxBodyBytes:    maxBodyBytes,
+				ServerBasePath:  basePath,
 			}
 
 			// check for overrides of SecurityDefinitions.
@@ -479,12 +818,62 @@ func OperationDefinitions(swagger *openapi3.T) ([]OperationDefinition, error) {
 			// Generate all the type definitions needed for this operation
 			opDef.TypeDefinitions = append(opDef.TypeDefinitions, GenerateTypeDefsForOperation(opDef)...)
 
+			if err := operationHook(&opDef); err != nil {
+				return nil, fmt.Errorf("error describing operation %s: %w", opDef.OperationID, err)
+			}
+
 			operations = append(operations, opDef)
 		}
 	}
 	return operations, nil
 }
 
+// extMiddlewaresOf returns the x-go-middlewares listed in extensions.
+func extMiddlewaresOf(extensions map[string]interface{}) ([]string, error) {
+	extension, ok := extensions[extMiddlewares]
+	if !ok {
+		return nil, nil
+	}
+	middlewares, err := extParseMiddlewares(extension)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for %q: %w", extMiddlewares, err)
+	}
+	return middlewares, nil
+}
+
+// middlewareChain concatenates the middlewares of each level, keeping only
+// the first occurrence of a middleware listed more than once.
+func middlewareChain(levels ...[]string) []string {
+	var chain []string
+	seen := make(map[string]bool)
+	for _, level := range levels {
+		for _, m := range level {
+			if !seen[m] {
+				seen[m] = true
+				chain = append(chain, m)
+			}
+		}
+	}
+	return chain
+}
+
+// extLimits returns the timeout and body size limit set by extensions, or
+// timeout and maxBodyBytes when they aren't.
+func extLimits(extensions map[string]interface{}, timeout time.Duration, maxBodyBytes int64) (time.Duration, int64, error) {
+	var err error
+	if extension, ok := extensions[extTimeout]; ok {
+		if timeout, err = extParseTimeout(extension); err != nil {
+			return 0, 0, fmt.Errorf("invalid value for %q: %w", extTimeout, err)
+		}
+	}
+	if extension, ok := extensions[extMaxBodyBytes]; ok {
+		if maxBodyBytes, err = extParseMaxBodyBytes(extension); err != nil {
+			return 0, 0, fmt.Errorf("invalid value for %q: %w", extMaxBodyBytes, err)
+		}
+	}
+	return timeout, maxBodyBytes, nil
+}
+
 func generateDefaultOperationID(opName string, requestPath string) (string, error) {
 	operationID := strings.ToLower(opName)
 
@@ -516,26 +905,36 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 	var bodyDefinitions []RequestBodyDefinition
 	var typeDefinitions []TypeDefinition
 
-	for contentType, content := range body.Content {
-		var tag string
-		var defaultBody bool
-
-		switch contentType {
-		case "application/json":
-			tag = "JSON"
-			defaultBody = true
-		default:
+	tags := make(map[string]bool)
+	for _, contentType := range SortedContentKeys(body.Content) {
+		content := body.Content[contentType]
+		tag, defaultBody := requestBodyTag(contentType)
+		// Only the first of the content types sharing a tag, such as
+		// application/xml and text/xml, gets a type.
+		if tag == "" || tags[tag] {
 			continue
 		}
+		tags[tag] = true
 
 		bodyTypeName := operationID + tag + "Body"
-		bodySchema, err := GenerateGoSchema(content.Schema, []string{bodyTypeName})
+		// Text and binary bodies are read as is, whatever their schema.
+		var bodySchema Schema
+		var err error
+		switch tag {
+		case "Text":
+			bodySchema = Schema{GoType: "string"}
+		case "Binary":
+			bodySchema = Schema{GoType: "[]byte"}
+		default:
+			bodySchema, err = GenerateGoSchema(content.Schema, []string{bodyTypeName})
+		}
 		if err != nil {
 			return nil, nil, fmt.Errorf("error generating request body definition: %w", err)
 		}
 
-		// If the body is a pre-defined type
-		if IsGoTypeReference(bodyOrRef.Ref) {
+		// If the body is a pre-defined type. Only JSON bodies get a type
+		// under #/components.
+		if defaultBody && IsGoTypeReference(bodyOrRef.Ref) {
 			// Convert the reference path to Go type
 			refType, err := RefPathToGoType(bodyOrRef.Ref)
 			if err != nil {
@@ -569,6 +968,25 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 	return bodyDefinitions, typeDefinitions, nil
 }
 
+// requestBodyTag returns the tag of the request body type generated for
+// contentType, and whether it is the default body type. No type is generated
+// for content types without a tag.
+func requestBodyTag(contentType string) (string, bool) {
+	switch {
+	case contentType == "application/json":
+		return "JSON", true
+	case StringInArray(contentType, contentTypesXML):
+		return "XML", false
+	case StringInArray(contentType, contentTypesYAML):
+		return "YAML", false
+	case contentType == "text/plain":
+		return "Text", false
+	case contentType == "application/octet-stream":
+		return "Binary", false
+	}
+	return "", false
+}
+
 // GenerateTypeDefsForOperation returns the type definitions for op.
 func GenerateTypeDefsForOperation(op OperationDefinition) []TypeDefinition {
 	var typeDefs []TypeDefinition
@@ -672,7 +1090,7 @@ func GenerateTypesForOperations(t *template.Template, ops []OperationDefinition)
 
 // GenerateChiServer generates codee for the chi server for ops.
 func GenerateChiServer(t *template.Template, operations []OperationDefinition) (string, error) {
-	return GenerateTemplates([]string{"interface.tmpl", "middleware.tmpl", "handler.tmpl"}, t, operations)
+	return GenerateTemplates([]string{"interface.tmpl", "middleware.tmpl", "handler.tmpl", "response-errors.tmpl"}, t, operations)
 }
 
 // GenerateTemplates generates templates
@@ -16,7 +16,9 @@ package codegen
 
 import (
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 )
@@ -154,3 +156,229 @@ func TestParameterDefinition_GoVariableName(t *testing.T) {
 		})
 	}
 }
+
+func TestParameterDefinition_PrimitiveParser(t *testing.T) {
+	int32Schema := Schema{GoType: "int32"}
+	tests := []struct {
+		name      string
+		style     string
+		schema    Schema
+		primitive bool
+		parser    string
+	}{
+		{name: "int32", schema: int32Schema, primitive: true, parser: "ParseInt32"},
+		{name: "string", schema: Schema{GoType: "string"}, primitive: true},
+		{name: "array", schema: Schema{GoType: "[]int32", ArrayType: &int32Schema}, primitive: true, parser: "ParseInt32Slice"},
+		{name: "named", schema: Schema{GoType: "string", RefType: "Status"}},
+		{name: "named array", schema: Schema{GoType: "[]int32", ArrayType: &int32Schema, RefType: "IDs"}},
+		{name: "array of named", schema: Schema{GoType: "[]Status", ArrayType: &Schema{GoType: "string", RefType: "Status"}}},
+		{name: "date", schema: Schema{GoType: "types.Date"}},
+		{name: "deepObject", style: "deepObject", schema: int32Schema},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pd := ParameterDefinition{
+				ParamName: "p",
+				In:        "query",
+				Spec:      &openapi3.Parameter{In: "query", Style: tt.style, Schema: &openapi3.SchemaRef{}},
+				Schema:    tt.schema,
+			}
+			if got := pd.IsPrimitive(); got != tt.primitive {
+				t.Errorf("ParameterDefinition.IsPrimitive() = %v, want %v", got, tt.primitive)
+			}
+			if !tt.primitive {
+				return
+			}
+			if got := pd.PrimitiveParser(); got != tt.parser {
+				t.Errorf("ParameterDefinition.PrimitiveParser() = %q, want %q", got, tt.parser)
+			}
+		})
+	}
+}
+
+func TestRawResponseTypeDefinition(t *testing.T) {
+	tests := []struct {
+		contentType string
+		schema      *openapi3.Schema
+		typeName    string
+		goType      string
+	}{
+		{contentType: "text/plain", schema: openapi3.NewStringSchema(), typeName: "TextPlain200", goType: "string"},
+		{contentType: "text/csv; charset=utf-8", typeName: "TextCsv200", goType: "string"},
+		{contentType: "image/png", schema: openapi3.NewStringSchema().WithFormat("binary"), typeName: "ImagePng200", goType: "io.Reader"},
+		{contentType: "image/*", typeName: "ImageAny200", goType: "io.Reader"},
+		{contentType: "application/pdf", schema: openapi3.NewBytesSchema(), typeName: "ApplicationPdf200", goType: "[]byte"},
+		{contentType: "application/octet-stream", schema: openapi3.NewIntegerSchema(), typeName: "ApplicationOctetStream200", goType: "[]byte"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.contentType, func(t *testing.T) {
+			var sref *openapi3.SchemaRef
+			if tt.schema != nil {
+				sref = openapi3.NewSchemaRef("", tt.schema)
+			}
+			td := rawResponseTypeDefinition("200", tt.contentType, sref)
+			if !td.Raw {
+				t.Errorf("rawResponseTypeDefinition() isn't raw")
+			}
+			if td.TypeName != tt.typeName {
+				t.Errorf("rawResponseTypeDefinition().TypeName = %v, want %v", td.TypeName, tt.typeName)
+			}
+			if got := td.Schema.TypeDecl(); got != tt.goType {
+				t.Errorf("rawResponseTypeDefinition().Schema.TypeDecl() = %v, want %v", got, tt.goType)
+			}
+			if td.ContentTypeName != tt.contentType {
+				t.Errorf("rawResponseTypeDefinition().ContentTypeName = %v, want %v", td.ContentTypeName, tt.contentType)
+			}
+		})
+	}
+}
+
+func TestGetNegotiatedResponseDefinitions(t *testing.T) {
+	content := func(types ...string) openapi3.Content {
+		c := openapi3.Content{}
+		for _, typ := range types {
+			schema := openapi3.NewStringSchema()
+			if typ == "application/yaml" {
+				schema = openapi3.NewIntegerSchema()
+			}
+			c[typ] = openapi3.NewMediaType().WithSchema(schema)
+		}
+		return c
+	}
+	responses := openapi3.Responses{
+		"200":     &openapi3.ResponseRef{Value: openapi3.NewResponse().WithContent(content("application/xml", "text/plain", "application/json"))},
+		"201":     &openapi3.ResponseRef{Value: openapi3.NewResponse().WithContent(content("application/json", "application/yaml"))},
+		"default": &openapi3.ResponseRef{Value: openapi3.NewResponse().WithContent(content("application/json", "text/plain"))},
+	}
+	op := OperationDefinition{OperationID: "GetThing", Spec: &openapi3.Operation{Responses: responses}}
+
+	nrds, err := op.GetNegotiatedResponseDefinitions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nrds) != 1 {
+		t.Fatalf("GetNegotiatedResponseDefinitions() = %v, want one definition", nrds)
+	}
+	if nrds[0].TypeName != "200" || nrds[0].Schema.TypeDecl() != "string" {
+		t.Errorf("GetNegotiatedResponseDefinitions() = %v, want the 200 string response", nrds[0])
+	}
+	if got := strings.Join(nrds[0].ContentTypeNames, ","); got != "application/json,application/xml" {
+		t.Errorf("GetNegotiatedResponseDefinitions()[0].ContentTypeNames = %v, want application/json,application/xml", got)
+	}
+}
+
+func TestGetErrorResponseDefinitions(t *testing.T) {
+	json := func(schema *openapi3.Schema) *openapi3.ResponseRef {
+		return &openapi3.ResponseRef{Value: openapi3.NewResponse().WithJSONSchema(schema)}
+	}
+	responses := openapi3.Responses{
+		"200":     json(openapi3.NewStringSchema()),
+		"404":     json(openapi3.NewStringSchema()),
+		"409":     &openapi3.ResponseRef{Value: openapi3.NewResponse()},
+		"4XX":     json(openapi3.NewIntegerSchema()),
+		"5XX":     json(openapi3.NewBoolSchema()),
+		"default": json(openapi3.NewIntegerSchema()),
+	}
+	op := OperationDefinition{OperationID: "getThing", Spec: &openapi3.Operation{Responses: responses}}
+
+	erds, err := op.GetErrorResponseDefinitions()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		responseName, condition, constructor, typeDecl string
+	}{
+		{"404", "code == 404", "GetThingJSON404Response", "string"},
+		{"409", "code == 409", "", ""},
+		{"4XX", "code/100 == 4", "GetThingJSON4xXResponse", "int"},
+		{"5XX", "code/100 == 5", "GetThingJSON5xXResponse", "bool"},
+		{"default", "", "GetThingJSONDefaultResponse", "int"},
+	}
+	if len(erds) != len(want) {
+		t.Fatalf("GetErrorResponseDefinitions() = %v, want %d definitions", erds, len(want))
+	}
+	for i, w := range want {
+		erd := erds[i]
+		if erd.ResponseName != w.responseName || erd.Condition != w.condition {
+			t.Errorf("GetErrorResponseDefinitions()[%d] = %s (%q), want %s (%q)", i, erd.ResponseName, erd.Condition, w.responseName, w.condition)
+		}
+		if w.constructor == "" {
+			if len(erd.Bodies) != 0 {
+				t.Errorf("GetErrorResponseDefinitions()[%d].Bodies = %v, want none", i, erd.Bodies)
+			}
+			continue
+		}
+		if len(erd.Bodies) != 1 || erd.Bodies[0].Constructor != w.constructor || erd.Bodies[0].TypeDecl != w.typeDecl {
+			t.Errorf("GetErrorResponseDefinitions()[%d].Bodies = %v, want %s(%s)", i, erd.Bodies, w.constructor, w.typeDecl)
+		}
+	}
+}
+
+func TestGenerateBodyDefinitions(t *testing.T) {
+	content := openapi3.NewContentWithJSONSchema(openapi3.NewObjectSchema())
+	for _, contentType := range []string{"application/xml", "text/xml", "application/x-yaml", "text/plain", "application/octet-stream", "multipart/form-data"} {
+		content[contentType] = openapi3.NewMediaType().WithSchema(openapi3.NewStringSchema().WithFormat("binary"))
+	}
+	body := &openapi3.RequestBodyRef{Value: openapi3.NewRequestBody().WithContent(content)}
+
+	bodies, typeDefs, err := GenerateBodyDefinitions("Upload", body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		tag, contentType, goType string
+	}{
+		{"JSON", "application/json", "map[string]interface{}"},
+		{"Binary", "application/octet-stream", "[]byte"},
+		{"YAML", "application/x-yaml", "openapi_types.File"},
+		{"XML", "application/xml", "openapi_types.File"},
+		{"Text", "text/plain", "string"},
+	}
+	if len(bodies) != len(want) || len(typeDefs) != len(want) {
+		t.Fatalf("GenerateBodyDefinitions() returned %d bodies and %d types, want %d", len(bodies), len(typeDefs), len(want))
+	}
+	for i, w := range want {
+		b, td := bodies[i], typeDefs[i]
+		typeName := "Upload" + w.tag + "Body"
+		if b.NameTag != w.tag || b.ContentType != w.contentType || b.Schema.TypeDecl() != typeName {
+			t.Errorf("GenerateBodyDefinitions()[%d] = %s %s %s, want %s %s %s", i, b.NameTag, b.ContentType, b.Schema.TypeDecl(), w.tag, w.contentType, typeName)
+		}
+		if b.Default != (w.tag == "JSON") {
+			t.Errorf("GenerateBodyDefinitions()[%d].Default = %v", i, b.Default)
+		}
+		if td.TypeName != typeName || td.Schema.TypeDecl() != w.goType {
+			t.Errorf("GenerateBodyDefinitions() type %d = %s %q, want %s %q", i, td.TypeName, td.Schema.TypeDecl(), typeName, w.goType)
+		}
+	}
+}
+
+func TestOperationDefinition_TimeoutDecl(t *testing.T) {
+	tests := map[time.Duration]string{
+		2 * time.Hour:           "2 * time.Hour",
+		90 * time.Second:        "90 * time.Second",
+		1500 * time.Millisecond: "1500 * time.Millisecond",
+		3 * time.Microsecond:    "3 * time.Microsecond",
+		42:                      "42 * time.Nanosecond",
+	}
+	for timeout, want := range tests {
+		op := OperationDefinition{Timeout: timeout}
+		if got := op.TimeoutDecl(); got != want {
+			t.Errorf("OperationDefinition.TimeoutDecl() = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestHasLimits(t *testing.T) {
+	ops := []OperationDefinition{{OperationID: "a"}, {OperationID: "b", Timeout: time.Second}}
+	if !hasTimeout(ops) {
+		t.Error("hasTimeout() = false, want true")
+	}
+	if hasMaxBodyBytes(ops) {
+		t.Error("hasMaxBodyBytes() = true, want false")
+	}
+	if hasTimeout(ops[:1]) {
+		t.Error("hasTimeout() = true, want false")
+	}
+}
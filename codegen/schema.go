@@ -3,6 +3,7 @@ package codegen
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
@@ -16,7 +17,10 @@ type Schema struct {
 
 	ArrayType *Schema // The schema of array element
 
-	EnumValues map[string]string // Enum values
+	EnumValues       map[string]string // Enum values, by constant name
+	EnumNames        []string          // Constant names of the enum values, in the order of the spec
+	EnumDescriptions map[string]string // Descriptions of the enum values, by constant name
+	ExtensibleEnum   bool              // Whether values which are not in EnumValues are accepted
 
 	Properties               []Property       // For an object, the fields with names
 	HasAdditionalProperties  bool             // Whether we support additional properties
@@ -141,6 +145,10 @@ type ResponseTypeDefinition struct {
 
 	// The type name of a response model.
 	ResponseName string
+
+	// Whether the body is written as is, rather than encoded, eg, for
+	// text/plain or image/png.
+	Raw bool
 }
 
 // CanAlias returns whether the name of the type can be aliased.
@@ -158,8 +166,20 @@ func PropertiesEqual(a, b Property) bool {
 
 // GenerateGoSchema generates the schema for sref.
 // If it cannot properly resolve the type of sref, it returns
-// map[string]interface{} or interface{}.
+// map[string]interface{} or interface{}. The SchemaPlugins of the running
+// Generator are called with the result, unless sref is a reference.
 func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
+	s, err := generateGoSchema(sref, path)
+	if err != nil || sref == nil || IsGoTypeReference(sref.Ref) {
+		return s, err
+	}
+	if err := schemaHook(path, sref.Value, &s); err != nil {
+		return Schema{}, err
+	}
+	return s, nil
+}
+
+func generateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 	// Add a fallback value in case the sref is nil.
 	// i.e. the parent schema defines a type:array, but the array has
 	// no items defined. Therefore we have at least valid Go-Code.
@@ -242,6 +262,11 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 		return outSchema, nil
 	}
 
+	enum, extensible, err := schemaEnum(schema)
+	if err != nil {
+		return Schema{}, err
+	}
+
 	// Schema type and format, eg. string / binary
 	t := schema.Type
 	// Handle objects and empty schemas first as a special case
@@ -336,27 +361,19 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			outSchema.GoType = GenStructFromSchema(outSchema)
 		}
 		return outSchema, nil
-	} else if len(schema.Enum) > 0 {
+	} else if len(enum) > 0 {
 		err := resolveType(schema, path, &outSchema)
 		if err != nil {
 			return Schema{}, fmt.Errorf("error resolving primitive type: %w", err)
 		}
-		enumValues := make([]string, len(schema.Enum))
-		for i, enumValue := range schema.Enum {
+		enumValues := make([]string, len(enum))
+		for i, enumValue := range enum {
 			enumValues[i] = fmt.Sprintf("%v", enumValue)
 		}
-
-		sanitizedValues := SanitizeEnumNames(enumValues)
-		outSchema.EnumValues = make(map[string]string, len(sanitizedValues))
-		var constNamePath []string
-		for k, v := range sanitizedValues {
-			if v == "" {
-				constNamePath = append(path, "Empty")
-			} else {
-				constNamePath = append(path, k)
-			}
-			outSchema.EnumValues[SchemaNameToTypeName(PathToTypeName(constNamePath))] = v
+		if err := genEnumValues(schema, path, enumValues, &outSchema); err != nil {
+			return Schema{}, err
 		}
+		outSchema.ExtensibleEnum = extensible
 		if len(path) > 1 { // handle additional type only on non-toplevel types
 			typeName := SchemaNameToTypeName(PathToTypeName(path))
 			typeDef := TypeDefinition{
@@ -371,7 +388,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 	} else {
 		err := resolveType(schema, path, &outSchema)
 		if err != nil {
-			return Schema{}, fmt.Errorf("error resolving primitive type")
+			return Schema{}, fmt.Errorf("error resolving primitive type: %w", err)
 		}
 	}
 	return outSchema, nil
@@ -382,6 +399,14 @@ func resolveType(schema *openapi3.Schema, path []string, outSchema *Schema) erro
 	f := schema.Format
 	t := schema.Type
 
+	if mapping, ok := mappedType(t, f); ok {
+		outSchema.GoType = mapping.GoType
+		if mapping.Import != nil {
+			outSchema.CustomImports = append(outSchema.CustomImports, mapping.Import.String())
+		}
+		return nil
+	}
+
 	switch t {
 	case "array":
 		// For arrays, we'll get the type of the Items and throw a
@@ -450,6 +475,16 @@ func resolveType(schema *openapi3.Schema, path []string, outSchema *Schema) erro
 			outSchema.GoType = "openapi_types.Date"
 		case "date-time":
 			outSchema.GoType = "time.Time"
+		case "uuid":
+			outSchema.GoType = "openapi_types.UUID"
+		case "uri":
+			outSchema.GoType = "openapi_types.URI"
+		case "ipv4", "ipv6":
+			outSchema.GoType = "openapi_types.IP"
+		case "duration":
+			outSchema.GoType = "openapi_types.Duration"
+		case "binary":
+			outSchema.GoType = "openapi_types.File"
 		case "json":
 			outSchema.GoType = "json.RawMessage"
 			outSchema.SkipOptionalPointer = true
@@ -463,6 +498,93 @@ func resolveType(schema *openapi3.Schema, path []string, outSchema *Schema) erro
 	return nil
 }
 
+// schemaEnum returns the enum values of schema, and whether values which are
+// not listed are accepted. x-extensible-enum either makes the enum of schema
+// extensible, or lists the values itself.
+func schemaEnum(schema *openapi3.Schema) ([]interface{}, bool, error) {
+	extension, ok := schema.Extensions[extExtensibleEnum]
+	if !ok {
+		return schema.Enum, false, nil
+	}
+
+	var extensible bool
+	if err := extParseAny(extension, &extensible); err == nil {
+		return schema.Enum, extensible, nil
+	}
+
+	var values []interface{}
+	if err := extParseAny(extension, &values); err != nil {
+		return nil, false, fmt.Errorf("invalid value for %q: %w", extExtensibleEnum, err)
+	}
+	if len(schema.Enum) > 0 {
+		return nil, false, fmt.Errorf("%q can't list values of a schema with an enum", extExtensibleEnum)
+	}
+	return values, true, nil
+}
+
+// genEnumValues sets the constants of the enum with values. Constants are
+// named after the values, or after x-enum-varnames, and prefixed by path.
+func genEnumValues(schema *openapi3.Schema, path []string, values []string, outSchema *Schema) error {
+	varNames := values
+	if extension, ok := schema.Extensions[extEnumVarNames]; ok {
+		names, err := extParseStrings(extension)
+		if err != nil {
+			return fmt.Errorf("invalid value for %q: %w", extEnumVarNames, err)
+		}
+		if len(names) != len(values) {
+			return fmt.Errorf("%q has %d names for %d enum values", extEnumVarNames, len(names), len(values))
+		}
+		varNames = names
+	}
+
+	var descriptions []string
+	if extension, ok := schema.Extensions[extEnumDescriptions]; ok {
+		var err error
+		descriptions, err = extParseStrings(extension)
+		if err != nil {
+			return fmt.Errorf("invalid value for %q: %w", extEnumDescriptions, err)
+		}
+		if len(descriptions) != len(values) {
+			return fmt.Errorf("%q has %d descriptions for %d enum values", extEnumDescriptions, len(descriptions), len(values))
+		}
+	}
+
+	outSchema.EnumValues = make(map[string]string, len(values))
+	outSchema.EnumDescriptions = make(map[string]string)
+	seen := make(map[string]bool, len(values))
+	dupCheck := make(map[string]int, len(values))
+	for i, v := range values {
+		if seen[v] {
+			continue
+		}
+		seen[v] = true
+
+		name := enumValueName(varNames[i], dupCheck)
+		constName := SchemaNameToTypeName(PathToTypeName(append(append([]string{}, path...), name)))
+		outSchema.EnumValues[constName] = v
+		outSchema.EnumNames = append(outSchema.EnumNames, constName)
+		if descriptions != nil && descriptions[i] != "" {
+			outSchema.EnumDescriptions[constName] = StringToGoComment(descriptions[i])
+		}
+	}
+	return nil
+}
+
+// enumValueName converts the name of an enum value to a Go identifier. Names
+// which were already returned, as counted by dupCheck, get a number suffix.
+func enumValueName(varName string, dupCheck map[string]int) string {
+	name := "Empty"
+	if varName != "" {
+		name = SanitizeGoIdentity(SchemaNameToTypeName(varName))
+	}
+	if n := dupCheck[name]; n > 0 {
+		dupCheck[name]++
+		return name + strconv.Itoa(n)
+	}
+	dupCheck[name]++
+	return name
+}
+
 // SchemaDescriptor describes a Schema, a type definition.
 type SchemaDescriptor struct {
 	Fields                   []FieldDescriptor
@@ -496,10 +618,16 @@ func GenFieldsFromProperties(props []Property) []string {
 		}
 		field += fmt.Sprintf("    %s %s", p.GoFieldName(), p.GoTypeDef())
 
+		// Properties added by plugins may not have extensions.
+		var extensions map[string]interface{}
+		if p.ExtensionProps != nil {
+			extensions = p.ExtensionProps.Extensions
+		}
+
 		// Support x-omitempty
 		omitEmpty := true
-		if _, ok := p.ExtensionProps.Extensions[extPropOmitEmpty]; ok {
-			if extOmitEmpty, err := extParseBool(p.ExtensionProps.Extensions[extPropOmitEmpty]); err == nil {
+		if _, ok := extensions[extPropOmitEmpty]; ok {
+			if extOmitEmpty, err := extParseBool(extensions[extPropOmitEmpty]); err == nil {
 				omitEmpty = extOmitEmpty
 			}
 		}
@@ -507,7 +635,7 @@ func GenFieldsFromProperties(props []Property) []string {
 		fieldTags := make(map[string]string)
 
 		fieldTags["json"] = p.JSONFieldName
-		if extension, ok := p.ExtensionProps.Extensions[extPropString]; ok {
+		if extension, ok := extensions[extPropString]; ok {
 			if extString, _ := extParseBool(extension); extString {
 				fieldTags["json"] += ",string"
 			}
@@ -515,7 +643,7 @@ func GenFieldsFromProperties(props []Property) []string {
 		if !p.Required && !p.Nullable && omitEmpty {
 			fieldTags["json"] = p.JSONFieldName + ",omitempty"
 		}
-		if extension, ok := p.ExtensionProps.Extensions[extPropExtraTags]; ok {
+		if extension, ok := extensions[extPropExtraTags]; ok {
 			if tags, err := extExtraTags(extension); err == nil {
 				keys := SortedStringKeys(tags)
 				for _, k := range keys {
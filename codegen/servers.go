@@ -0,0 +1,142 @@
+package codegen
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// ServerDefinition describes a server of the spec.
+type ServerDefinition struct {
+	Name        string                     // Go name of the server, such as Production for ServerURLProduction
+	URL         string                     // URL of the server, which may reference Variables, such as https://{env}.example.com
+	Description string                     // Go comment of the description of the server
+	Variables   []ServerVariableDefinition // Sorted by name
+}
+
+// ServerVariableDefinition describes a variable of the URL of a server.
+type ServerVariableDefinition struct {
+	Name        string
+	GoName      string
+	Default     string
+	Enum        []string
+	Description string // Go comment of the description of the variable
+}
+
+// DefaultURL returns the URL of s with the default values of its variables.
+func (s ServerDefinition) DefaultURL() string {
+	return substituteServerVariables(s.URL, s.Variables)
+}
+
+// ServerDefinitions describes servers. Servers are named by their x-go-name
+// extension, their description, or else their index. Names taken by a
+// previous server get a number suffix.
+func ServerDefinitions(servers openapi3.Servers) ([]ServerDefinition, error) {
+	defs := make([]ServerDefinition, 0, len(servers))
+	names := make(map[string]bool, len(servers))
+	for i, server := range servers {
+		name, err := serverGoName(server, i)
+		if err != nil {
+			return nil, err
+		}
+		for n, base := 2, name; names[name]; n++ {
+			name = base + strconv.Itoa(n)
+		}
+		names[name] = true
+
+		def := ServerDefinition{
+			Name:        name,
+			URL:         server.URL,
+			Description: StringToGoComment(server.Description),
+		}
+		for _, varName := range sortedServerVariables(server) {
+			v := server.Variables[varName]
+			def.Variables = append(def.Variables, ServerVariableDefinition{
+				Name:        varName,
+				GoName:      SchemaNameToTypeName(varName),
+				Default:     v.Default,
+				Enum:        v.Enum,
+				Description: StringToGoComment(v.Description),
+			})
+		}
+		defs = append(defs, def)
+	}
+	return defs, nil
+}
+
+func serverGoName(server *openapi3.Server, i int) (string, error) {
+	if ext, ok := server.Extensions[extGoName]; ok {
+		var name string
+		if err := extParseAny(ext, &name); err != nil {
+			return "", fmt.Errorf("server %s: invalid %s: %w", server.URL, extGoName, err)
+		}
+		return SchemaNameToTypeName(name), nil
+	}
+	if server.Description != "" {
+		return ToCamelCase(server.Description), nil
+	}
+	return strconv.Itoa(i), nil
+}
+
+func sortedServerVariables(server *openapi3.Server) []string {
+	names := make([]string, 0, len(server.Variables))
+	for name := range server.Variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func substituteServerVariables(serverURL string, variables []ServerVariableDefinition) string {
+	for _, v := range variables {
+		serverURL = strings.ReplaceAll(serverURL, "{"+v.Name+"}", v.Default)
+	}
+	return serverURL
+}
+
+// serverBasePath returns the path of the URL of the first of servers, with
+// the default values of its variables. It is "/" if there are no servers.
+func serverBasePath(servers openapi3.Servers) (string, error) {
+	if len(servers) == 0 {
+		return "/", nil
+	}
+	defs, err := ServerDefinitions(servers[:1])
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(defs[0].DefaultURL())
+	if err != nil {
+		return "", fmt.Errorf("server %s: %w", servers[0].URL, err)
+	}
+	if u.Path == "" || u.Path == "/" {
+		return "/", nil
+	}
+	return strings.TrimSuffix(u.Path, "/"), nil
+}
+
+// GenerateServerURLs generates the helpers returning the URLs of servers, and
+// the default base URL of the generated server.
+func GenerateServerURLs(t *template.Template, servers openapi3.Servers) (string, error) {
+	defs, err := ServerDefinitions(servers)
+	if err != nil {
+		return "", err
+	}
+	basePath, err := serverBasePath(servers)
+	if err != nil {
+		return "", err
+	}
+
+	context := struct {
+		Servers  []ServerDefinition
+		BasePath string
+	}{
+		Servers:  defs,
+		BasePath: basePath,
+	}
+	return GenerateTemplates([]string{"servers.tmpl"}, t, context)
+}
@@ -0,0 +1,69 @@
+package codegen
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerDefinitions(t *testing.T) {
+	local := &openapi3.Server{URL: "http://localhost:8080"}
+	local.Extensions = map[string]interface{}{extGoName: json.RawMessage(`"local"`)}
+	servers := openapi3.Servers{
+		{
+			URL:         "https://{env}.example.com/v1",
+			Description: "production",
+			Variables: map[string]*openapi3.ServerVariable{
+				"env": {Default: "api", Enum: []string{"api", "staging"}},
+			},
+		},
+		local,
+		{URL: "/v2"},
+	}
+
+	defs, err := ServerDefinitions(servers)
+	require.NoError(t, err)
+	require.Len(t, defs, 3)
+	assert.Equal(t, "Production", defs[0].Name)
+	assert.Equal(t, "https://api.example.com/v1", defs[0].DefaultURL())
+	assert.Equal(t, []ServerVariableDefinition{{Name: "env", GoName: "Env", Default: "api", Enum: []string{"api", "staging"}}}, defs[0].Variables)
+	assert.Equal(t, "Local", defs[1].Name)
+	assert.Equal(t, "2", defs[2].Name)
+
+	// Names taken by a previous server, including by its index, get a
+	// suffix.
+	defs, err = ServerDefinitions(openapi3.Servers{local, local, local, {URL: "/"}, {URL: "/v1", Description: "3"}})
+	require.NoError(t, err)
+	names := make([]string, len(defs))
+	for i, def := range defs {
+		names[i] = def.Name
+	}
+	assert.Equal(t, []string{"Local", "Local2", "Local3", "3", "32"}, names)
+}
+
+func TestServerBasePath(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: "", want: "/"},
+		{url: "http://example.com", want: "/"},
+		{url: "http://example.com/", want: "/"},
+		{url: "https://example.com/api/", want: "/api"},
+		{url: "/v1", want: "/v1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			var servers openapi3.Servers
+			if tt.url != "" {
+				servers = openapi3.Servers{{URL: tt.url}}
+			}
+			got, err := serverBasePath(servers)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -68,6 +68,22 @@ func getResponseTypeDefinitions(op *OperationDefinition) []ResponseTypeDefinitio
 	return td
 }
 
+func getNegotiatedResponseDefinitions(op *OperationDefinition) []NegotiatedResponseDefinition {
+	nrds, err := op.GetNegotiatedResponseDefinitions()
+	if err != nil {
+		panic(err)
+	}
+	return nrds
+}
+
+func getErrorResponseDefinitions(op *OperationDefinition) []ErrorResponseDefinition {
+	erds, err := op.GetErrorResponseDefinitions()
+	if err != nil {
+		panic(err)
+	}
+	return erds
+}
+
 func getTaggedMiddlewares(ops []OperationDefinition) []string {
 	middlewares := make(map[string]struct{})
 	for _, op := range ops {
@@ -85,6 +101,37 @@ func getTaggedMiddlewares(ops []OperationDefinition) []string {
 	return keys
 }
 
+// hasTimeout returns whether any of ops has an x-go-timeout.
+func hasTimeout(ops []OperationDefinition) bool {
+	for _, op := range ops {
+		if op.Timeout != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// hasMaxBodyBytes returns whether any of ops has an x-go-max-body-bytes.
+func hasMaxBodyBytes(ops []OperationDefinition) bool {
+	for _, op := range ops {
+		if op.MaxBodyBytes != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// hasOperationServers returns whether any of ops is served under the base
+// path of servers of its own.
+func hasOperationServers(ops []OperationDefinition) bool {
+	for _, op := range ops {
+		if op.ServerBasePath != "" {
+			return true
+		}
+	}
+	return false
+}
+
 // This outputs a string array
 func toStringArray(sarr []string) string {
 	return `[]string{"` + strings.Join(sarr, `","`) + `"}`
@@ -115,11 +162,16 @@ func TitleWord(s string) string {
 // TemplateFunctions generates the list of utlity and helpfer functions used by
 // the templates.
 var TemplateFunctions = template.FuncMap{
-	"genParamArgs":               genParamArgs,
-	"genParamNames":              genParamNames,
-	"getResponseTypeDefinitions": getResponseTypeDefinitions,
-	"genTaggedMiddleware":        getTaggedMiddlewares,
-	"toStringArray":              toStringArray,
+	"genParamArgs":                     genParamArgs,
+	"genParamNames":                    genParamNames,
+	"getResponseTypeDefinitions":       getResponseTypeDefinitions,
+	"getNegotiatedResponseDefinitions": getNegotiatedResponseDefinitions,
+	"getErrorResponseDefinitions":      getErrorResponseDefinitions,
+	"genTaggedMiddleware":              getTaggedMiddlewares,
+	"hasTimeout":                       hasTimeout,
+	"hasMaxBodyBytes":                  hasMaxBodyBytes,
+	"hasOperationServers":              hasOperationServers,
+	"toStringArray":                    toStringArray,
 
 	"swaggerURIToChiURI": SwaggerURIToChiURI,
 
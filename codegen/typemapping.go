@@ -0,0 +1,108 @@
+package codegen
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// typeMapping is the Go type used for schemas of a type and format.
+type typeMapping struct {
+	GoType string    // qualified Go type, eg uuid.UUID
+	Import *goImport // package of GoType, nil for local and predeclared types
+}
+
+// typeMap maps "type/format" of schemas, or "type" for schemas of any format
+// without a mapping of its own, to Go types.
+type typeMap map[string]typeMapping
+
+var (
+	// typeMappings is guarded by generateMu, like importMapping.
+	typeMappings typeMap
+
+	goIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	majorVersion = regexp.MustCompile(`^v[0-9]+$`)
+	gopkgVersion = regexp.MustCompile(`\.v[0-9]+$`)
+)
+
+// constructTypeMapping parses the type mappings of the options. Keys are
+// "type/format", eg "string/uuid", or "type" for schemas of any other format.
+// Values are Go types qualified by their import path, eg
+// "github.com/google/uuid.UUID", or unqualified for types of the generated
+// package.
+func constructTypeMapping(input map[string]string) (typeMap, error) {
+	result := typeMap{}
+	for key, value := range input {
+		typ := key
+		if i := strings.Index(key, "/"); i >= 0 {
+			typ = key[:i]
+		}
+		switch typ {
+		case "integer", "number", "string", "boolean":
+		default:
+			return nil, fmt.Errorf("invalid type mapping %q: unsupported type %q", key, typ)
+		}
+
+		mapping, err := parseGoType(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid type mapping %q: %w", key, err)
+		}
+		result[key] = mapping
+	}
+	return result, nil
+}
+
+// parseGoType parses a Go type qualified by its import path.
+func parseGoType(s string) (typeMapping, error) {
+	i := strings.LastIndex(s, ".")
+	if i < 0 {
+		if !goIdentifier.MatchString(s) {
+			return typeMapping{}, fmt.Errorf("invalid Go type %q", s)
+		}
+		return typeMapping{GoType: s}, nil
+	}
+
+	importPath, name := s[:i], s[i+1:]
+	if importPath == "" || !goIdentifier.MatchString(name) {
+		return typeMapping{}, fmt.Errorf("invalid Go type %q", s)
+	}
+
+	// Guess the package name from the import path, skipping major versions
+	// and the usual go- prefixes and gopkg.in versions. The package is imported with that name if
+	// it isn't the last element of the path.
+	pkg := path.Base(importPath)
+	if dir := path.Dir(importPath); majorVersion.MatchString(pkg) && dir != "." {
+		pkg = path.Base(dir)
+	}
+	last := path.Base(importPath)
+	pkg = gopkgVersion.ReplaceAllString(pkg, "")
+	pkg = strings.TrimSuffix(strings.TrimPrefix(pkg, "go-"), ".go")
+	pkg = strings.Map(func(r rune) rune {
+		if r == '-' || r == '.' {
+			return -1
+		}
+		return r
+	}, pkg)
+	if !goIdentifier.MatchString(pkg) {
+		return typeMapping{}, fmt.Errorf("invalid import path %q", importPath)
+	}
+
+	imp := &goImport{Path: importPath}
+	if pkg != last {
+		imp.Name = pkg
+	}
+	return typeMapping{GoType: pkg + "." + name, Import: imp}, nil
+}
+
+// mappedType returns the type mapping for schemas of type t and format f, or
+// else for schemas of type t.
+func mappedType(t, f string) (typeMapping, bool) {
+	if f != "" {
+		if m, ok := typeMappings[t+"/"+f]; ok {
+			return m, ok
+		}
+	}
+	m, ok := typeMappings[t]
+	return m, ok
+}
@@ -0,0 +1,237 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGoType(t *testing.T) {
+	tests := []struct {
+		in     string
+		goType string
+		imp    string
+		err    string
+	}{
+		{in: "ID", goType: "ID"},
+		{in: "github.com/google/uuid.UUID", goType: "uuid.UUID", imp: `"github.com/google/uuid"`},
+		{in: "github.com/shopspring/decimal.Decimal", goType: "decimal.Decimal", imp: `"github.com/shopspring/decimal"`},
+		{in: "github.com/jackc/pgx/v4.Identifier", goType: "pgx.Identifier", imp: `pgx "github.com/jackc/pgx/v4"`},
+		{in: "gopkg.in/yaml.v3.Node", goType: "yaml.Node", imp: `yaml "gopkg.in/yaml.v3"`},
+		{in: "github.com/example/go-money.Amount", goType: "money.Amount", imp: `money "github.com/example/go-money"`},
+		{in: "mypkg.ID", goType: "mypkg.ID", imp: `"mypkg"`},
+		{in: "github.com/google/uuid.", err: `invalid Go type "github.com/google/uuid."`},
+		{in: "[]string", err: `invalid Go type "[]string"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			m, err := parseGoType(tt.in)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.goType, m.GoType)
+			if tt.imp == "" {
+				assert.Nil(t, m.Import)
+			} else {
+				require.NotNil(t, m.Import)
+				assert.Equal(t, tt.imp, m.Import.String())
+			}
+		})
+	}
+}
+
+func TestTypeMappings(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(typeMappingsTestSpec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, "api", Options{
+		GenerateTypes:  true,
+		GenerateServer: true,
+		TypeMappings: map[string]string{
+			"string/uuid":    "github.com/google/uuid.UUID",
+			"string/decimal": "github.com/shopspring/decimal.Decimal",
+			"integer/int64":  "ID",
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, code, `"github.com/google/uuid"`)
+	assert.Contains(t, code, `"github.com/shopspring/decimal"`)
+
+	// Schemas
+	assert.Regexp(t, `ID\s+uuid\.UUID\s+`+"`json:\"id\"`", code)
+	assert.Regexp(t, `Price\s+\*decimal\.Decimal\s+`+"`json:\"price,omitempty\"`", code)
+	assert.Regexp(t, `Tags\s+\[\]uuid\.UUID\s+`+"`json:\"tags,omitempty\"`", code)
+	assert.Regexp(t, `Count\s+int\s+`, code)
+
+	// Parameters
+	assert.Contains(t, code, "var orderID uuid.UUID")
+	assert.Regexp(t, `Version\s+\*ID\s+`+"`json:\"version,omitempty\"`", code)
+
+	// Request bodies
+	assert.Regexp(t, `Amount\s+decimal\.Decimal\s+`+"`json:\"amount\"`", code)
+}
+
+func TestTypeMappingsFallback(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(typeMappingsFallbackTestSpec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, "api", Options{
+		GenerateTypes:  true,
+		GenerateServer: true,
+		TypeMappings: map[string]string{
+			"integer":     "Number",
+			"string":      "Code",
+			"string/uuid": "github.com/google/uuid.UUID",
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Regexp(t, `Count\s+Number\s+`, code)
+	assert.Regexp(t, `Sku\s+Code\s+`, code)
+	assert.Regexp(t, `ID\s+uuid\.UUID\s+`, code)
+
+	// Enums of mapped types decode text according to their kind.
+	assert.Contains(t, code, "var value Code\n\tif err := runtime.BindStringToObject(string(data), &value)")
+	assert.NotContains(t, code, "UnmarshalJSON(data)\n}")
+}
+
+func TestStringFormats(t *testing.T) {
+	formats := map[string]string{
+		"":          "string",
+		"hostname":  "string",
+		"email":     "openapi_types.Email",
+		"date":      "openapi_types.Date",
+		"date-time": "time.Time",
+		"uuid":      "openapi_types.UUID",
+		"uri":       "openapi_types.URI",
+		"ipv4":      "openapi_types.IP",
+		"ipv6":      "openapi_types.IP",
+		"duration":  "openapi_types.Duration",
+		"binary":    "openapi_types.File",
+		"byte":      "[]byte",
+	}
+	for format, goType := range formats {
+		var out Schema
+		err := resolveType(&openapi3.Schema{Type: "string", Format: format}, nil, &out)
+		require.NoError(t, err)
+		assert.Equal(t, goType, out.GoType, format)
+	}
+}
+
+func TestTypeMappingsInvalid(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(typeMappingsTestSpec))
+	require.NoError(t, err)
+
+	_, err = Generate(swagger, "api", Options{
+		GenerateTypes: true,
+		TypeMappings:  map[string]string{"object/thing": "Thing"},
+	})
+	assert.EqualError(t, err, `invalid type mapping "object/thing": unsupported type "object"`)
+}
+
+const typeMappingsTestSpec = `
+openapi: 3.0.1
+info:
+  title: Type mappings test
+  version: 1.0.0
+paths:
+  /orders/{orderId}:
+    put:
+      operationId: updateOrder
+      parameters:
+        - name: orderId
+          in: path
+          required: true
+          schema:
+            type: string
+            format: uuid
+        - name: version
+          in: query
+          schema:
+            type: integer
+            format: int64
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              type: object
+              required: [amount]
+              properties:
+                amount:
+                  type: string
+                  format: decimal
+      responses:
+        '200':
+          description: order
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Order'
+components:
+  schemas:
+    Order:
+      type: object
+      required: [id, count]
+      properties:
+        id:
+          type: string
+          format: uuid
+        price:
+          type: string
+          format: decimal
+        tags:
+          type: array
+          items:
+            type: string
+            format: uuid
+        count:
+          type: integer
+`
+
+const typeMappingsFallbackTestSpec = `
+openapi: 3.0.1
+info:
+  title: Type mappings fallback test
+  version: 1.0.0
+paths:
+  /items:
+    get:
+      operationId: listItems
+      responses:
+        '200':
+          description: items
+          content:
+            application/json:
+              schema:
+                type: object
+                properties:
+                  item:
+                    $ref: '#/components/schemas/Item'
+                  grade:
+                    $ref: '#/components/schemas/Grade'
+components:
+  schemas:
+    Item:
+      type: object
+      required: [id, count, sku]
+      properties:
+        id:
+          type: string
+          format: uuid
+        count:
+          type: integer
+          format: int32
+        sku:
+          type: string
+          format: sku
+    Grade:
+      type: string
+      format: grade
+      enum: [a, b]
+`
@@ -19,7 +19,6 @@ import (
 	"net/url"
 	"regexp"
 	"sort"
-	"strconv"
 	"strings"
 	"unicode"
 
@@ -449,32 +448,22 @@ func SanitizeGoIdentity(str string) string {
 }
 
 // SanitizeEnumNames removes illegal and duplicates chars in enum names.
+//
+// Deprecated: SanitizeEnumNames is no longer used by the generator, which
+// names enum values after their x-enum-varnames or themselves. It names values
+// the same way.
 func SanitizeEnumNames(enumNames []string) map[string]string {
+	sanitized := make(map[string]string, len(enumNames))
+	seen := make(map[string]bool, len(enumNames))
 	dupCheck := make(map[string]int, len(enumNames))
-	deDup := make([]string, 0, len(enumNames))
-
 	for _, n := range enumNames {
-		if _, dup := dupCheck[n]; !dup {
-			deDup = append(deDup, n)
-		}
-		dupCheck[n] = 0
-	}
-
-	dupCheck = make(map[string]int, len(deDup))
-	sanitizedDeDup := make(map[string]string, len(deDup))
-
-	for _, n := range deDup {
-		sanitized := SanitizeGoIdentity(SchemaNameToTypeName(n))
-
-		if _, dup := dupCheck[sanitized]; !dup {
-			sanitizedDeDup[sanitized] = n
-		} else {
-			sanitizedDeDup[sanitized+strconv.Itoa(dupCheck[sanitized])] = n
+		if seen[n] {
+			continue
 		}
-		dupCheck[sanitized]++
+		seen[n] = true
+		sanitized[enumValueName(n, dupCheck)] = n
 	}
-
-	return sanitizedDeDup
+	return sanitized
 }
 
 // SchemaNameToTypeName converts name to a valid Go type name.
@@ -321,3 +321,11 @@ func TestEscapePathElements(t *testing.T) {
 	p = "/foo/bar:baz"
 	assert.Equal(t, "/foo/bar%3Abaz", EscapePathElements(p))
 }
+
+func TestSanitizeEnumNames(t *testing.T) {
+	assert.Equal(t, map[string]string{
+		"FooBar":  "foo-bar",
+		"FooBar1": "foo_bar",
+		"Empty":   "",
+	}, SanitizeEnumNames([]string{"foo-bar", "foo_bar", "foo-bar", ""}))
+}
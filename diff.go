@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/discord-gophers/goapi-gen/codegen"
+	"github.com/urfave/cli/v2"
+)
+
+func diff(c *cli.Context, cfg *config) error {
+	if c.Args().Len() != 2 {
+		return fmt.Errorf("expected an old and a new spec, got %d argument(s)", c.Args().Len())
+	}
+
+	opts, err := generateOptions(cfg)
+	if err != nil {
+		return err
+	}
+
+	oldSwagger, err := loadSpec(cfg, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	newSwagger, err := loadSpec(cfg, c.Args().Get(1))
+	if err != nil {
+		return err
+	}
+
+	var changes []codegen.APIChange
+	err = withInitialisms(cfg.Initialisms, func() (err error) {
+		changes, err = codegen.DiffAPI(oldSwagger, newSwagger, opts)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	switch format := c.String(FormatKey); format {
+	case "text":
+		for _, change := range changes {
+			fmt.Println(change)
+		}
+	case "json":
+		if changes == nil {
+			changes = []codegen.APIChange{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(changes); err != nil {
+			return fmt.Errorf("could not write changes: %v", err)
+		}
+	default:
+		return fmt.Errorf("unknown output format: %s", format)
+	}
+
+	var breaking int
+	for _, change := range changes {
+		if change.Breaking {
+			breaking++
+		}
+	}
+	if breaking > 0 {
+		return fmt.Errorf("found %d breaking change(s)", breaking)
+	}
+	return nil
+}
@@ -9,16 +9,19 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
 
+	"gopkg.in/yaml.v3"
+
 	"github.com/discord-gophers/goapi-gen/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/render"
 )
 
 // Error defines model for Error.
@@ -72,13 +75,62 @@ type Response struct {
 	body        interface{}
 	Code        int
 	contentType string
+	offers      []string
+	raw         bool
 }
 
-// Render implements the render.Renderer interface. It sets the Content-Type header
-// and status code based on the response definition.
+// Render writes the response. It sets the Content-Type header and status code
+// based on the response definition, and writes the body encoded for its content type.
+// Raw bodies, such as text/plain or image/png, are written as is.
+// Nothing is written if the body can't be encoded.
+//
+// Responses with several representations are written in the one which best
+// matches the Accept header of the request, or in the default content type
+// when none is acceptable.
 func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
-	w.Header().Set("Content-Type", resp.contentType)
-	render.Status(r, resp.Code)
+	contentType := resp.contentType
+	if accept := r.Header.Get("Accept"); accept != "" && len(resp.offers) > 0 {
+		offers := append([]string{contentType}, resp.offers...)
+		if ct := runtime.NegotiateContentType(accept, offers...); ct != "" {
+			contentType = ct
+		}
+	}
+
+	if resp.raw {
+		w.Header().Set("Content-Type", contentType)
+		w.WriteHeader(resp.Code)
+		switch body := resp.body.(type) {
+		case string:
+			io.WriteString(w, body)
+		case []byte:
+			w.Write(body)
+		case io.Reader:
+			if c, ok := body.(io.Closer); ok {
+				defer c.Close()
+			}
+			io.Copy(w, body)
+		}
+		return nil
+	}
+
+	var data []byte
+	var err error
+	switch {
+	case strings.Contains(contentType, "xml"):
+		data, err = xml.Marshal(resp)
+		data = append([]byte(xml.Header), data...)
+	case strings.Contains(contentType, "yaml"):
+		data, err = runtime.MarshalYAML(resp.body)
+	default:
+		data, err = json.Marshal(resp.body)
+	}
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(resp.Code)
+	w.Write(data)
 	return nil
 }
 
@@ -89,6 +141,8 @@ func (resp *Response) Status(code int) *Response {
 }
 
 // ContentType is a builder method to override the default content type for a response.
+// The default content type of a response with several representations is
+// used when the Accept header of the request allows none of them.
 func (resp *Response) ContentType(contentType string) *Response {
 	resp.contentType = contentType
 	return resp
@@ -176,6 +230,16 @@ func FindPetByIDJSONDefaultResponse(body Error) *Response {
 	}
 }
 
+// defaultBaseURL is the base URL of the server, the path of the URL of the
+// first server of the specification.
+const defaultBaseURL = "/api"
+
+// ServerURL0 returns the URL of the server
+// http://petstore.swagger.io/api.
+func ServerURL0() string {
+	return "http://petstore.swagger.io/api"
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Returns all pets
@@ -196,36 +260,58 @@ type ServerInterface interface {
 type ServerInterfaceWrapper struct {
 	Handler          ServerInterface
 	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	Observer         runtime.Observer
 }
 
 // FindPets operation middleware
 func (siw *ServerInterfaceWrapper) FindPets(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "FindPets", "/pets")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params FindPetsParams
 
+	query := r.URL.Query()
+
 	// ------------- Optional query parameter "tags" -------------
 
-	if err := runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags); err != nil {
+	if raw, found, err := runtime.QueryParameterValues("form", true, "tags", query); err != nil {
 		err = fmt.Errorf("invalid format for parameter tags: %w", err)
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tags"})
 		return
+	} else if found {
+		params.Tags = raw
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	if err := runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit); err != nil {
+	if raw, found, err := runtime.QueryParameterValue("form", true, "limit", query); err != nil {
 		err = fmt.Errorf("invalid format for parameter limit: %w", err)
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limit"})
 		return
+	} else if found {
+		if value, err := runtime.ParseInt32(raw); err != nil {
+			err = fmt.Errorf("invalid format for parameter limit: %w", err)
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limit"})
+			return
+		} else {
+			params.Limit = &value
+		}
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.FindPets(w, r, params)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -239,11 +325,20 @@ func (siw *ServerInterfaceWrapper) FindPets(w http.ResponseWriter, r *http.Reque
 func (siw *ServerInterfaceWrapper) AddPet(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "AddPet", "/pets")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.AddPet(w, r)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -257,10 +352,20 @@ func (siw *ServerInterfaceWrapper) AddPet(w http.ResponseWriter, r *http.Request
 func (siw *ServerInterfaceWrapper) DeletePet(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "DeletePet", "/pets/{id}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "id" -------------
 	var id int64
 
-	if err := runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id); err != nil {
+	if raw, err := runtime.StyledParameterValue("simple", false, "id", chi.URLParam(r, "id")); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "id"})
+		return
+	} else if id, err = runtime.ParseInt64(raw); err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "id"})
 		return
 	}
@@ -268,8 +373,10 @@ func (siw *ServerInterfaceWrapper) DeletePet(w http.ResponseWriter, r *http.Requ
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.DeletePet(w, r, id)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -283,10 +390,20 @@ func (siw *ServerInterfaceWrapper) DeletePet(w http.ResponseWriter, r *http.Requ
 func (siw *ServerInterfaceWrapper) FindPetByID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "FindPetByID", "/pets/{id}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "id" -------------
 	var id int64
 
-	if err := runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id); err != nil {
+	if raw, err := runtime.StyledParameterValue("simple", false, "id", chi.URLParam(r, "id")); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "id"})
+		return
+	} else if id, err = runtime.ParseInt64(raw); err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "id"})
 		return
 	}
@@ -294,8 +411,10 @@ func (siw *ServerInterfaceWrapper) FindPetByID(w http.ResponseWriter, r *http.Re
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.FindPetByID(w, r, id)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -395,17 +514,33 @@ type ServerOptions struct {
 	BaseURL          string
 	BaseRouter       chi.Router
 	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	Observer         runtime.Observer
+	SpecRoute        string
+	SpecUIRoute      string
+	SpecUI           func(specURL string) http.Handler
 }
 
 type ServerOption func(*ServerOptions)
 
 // Handler creates http.Handler with routing matching OpenAPI spec.
+// It panics if the options are invalid, such as when a tagged middleware is missing.
 func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
+	h, err := NewHandler(si, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// NewHandler creates http.Handler with routing matching OpenAPI spec.
+// An error is returned if the options are invalid, such as when a tagged middleware is missing.
+func NewHandler(si ServerInterface, opts ...ServerOption) (http.Handler, error) {
 	options := &ServerOptions{
-		BaseURL:    "/",
+		BaseURL:    defaultBaseURL,
 		BaseRouter: chi.NewRouter(),
 		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			status := http.StatusBadRequest
+			http.Error(w, err.Error(), status)
 		},
 	}
 
@@ -417,6 +552,18 @@ func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
 	wrapper := ServerInterfaceWrapper{
 		Handler:          si,
 		ErrorHandlerFunc: options.ErrorHandlerFunc,
+		Observer:         options.Observer,
+	}
+
+	// Errors, such as parameter binding errors, are recorded on the observed
+	// call of the operation.
+	wrapper.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+		runtime.SetCallError(r.Context(), err)
+		options.ErrorHandlerFunc(w, r, err)
+	}
+
+	if options.SpecUI != nil && options.SpecRoute == "" {
+		return nil, errors.New("goapi-gen: WithSpecUI requires WithSpecRoute")
 	}
 
 	r.Route(options.BaseURL, func(r chi.Router) {
@@ -424,8 +571,17 @@ func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
 		r.Post("/pets", wrapper.AddPet)
 		r.Delete("/pets/{id}", wrapper.DeletePet)
 		r.Get("/pets/{id}", wrapper.FindPetByID)
+
+		if options.SpecRoute != "" {
+			r.Method(http.MethodGet, options.SpecRoute, SpecHandler(opts...))
+		}
+		if options.SpecUI != nil {
+			ui := options.SpecUI(path.Join(options.BaseURL, options.SpecRoute))
+			r.Method(http.MethodGet, options.SpecUIRoute, ui)
+			r.Method(http.MethodGet, strings.TrimSuffix(options.SpecUIRoute, "/")+"/*", ui)
+		}
 	})
-	return r
+	return r, nil
 }
 
 func WithRouter(r chi.Router) ServerOption {
@@ -446,6 +602,93 @@ func WithErrorHandler(handler func(w http.ResponseWriter, r *http.Request, err e
 	}
 }
 
+// WithObserver reports each call of an operation to o once it returns, with
+// its operation ID, method, route template, status code, response size,
+// duration and the error passed to the error handler, if any.
+func WithObserver(o runtime.Observer) ServerOption {
+	return func(s *ServerOptions) {
+		s.Observer = o
+	}
+}
+
+// WithSpecRoute serves the embedded OpenAPI specification at pattern, relative
+// to the base URL. See SpecHandler.
+func WithSpecRoute(pattern string) ServerOption {
+	return func(s *ServerOptions) {
+		s.SpecRoute = pattern
+	}
+}
+
+// WithSpecUI serves an API explorer for the specification served by
+// WithSpecRoute at pattern, relative to the base URL. ui creates the
+// explorer's handler from the URL of the specification, for example
+// specui.SwaggerUI.
+func WithSpecUI(pattern string, ui func(specURL string) http.Handler) ServerOption {
+	return func(s *ServerOptions) {
+		s.SpecUIRoute = pattern
+		s.SpecUI = ui
+	}
+}
+
+// SpecHandler returns an http.Handler serving the embedded OpenAPI
+// specification as JSON, or as YAML if the Accept header prefers it. The
+// servers of the specification are replaced by the request's host and the
+// base URL of opts.
+func SpecHandler(opts ...ServerOption) http.Handler {
+	options := &ServerOptions{
+		BaseURL: defaultBaseURL,
+	}
+	for _, f := range opts {
+		f(options)
+	}
+
+	swagger, loadErr := GetSwagger()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if loadErr != nil {
+			http.Error(w, loadErr.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		scheme := "http"
+		if r.TLS != nil {
+			scheme = "https"
+		}
+		if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+			scheme = proto
+		}
+
+		spec := *swagger
+		spec.Servers = openapi3.Servers{
+			{URL: scheme + "://" + r.Host + strings.TrimSuffix(options.BaseURL, "/")},
+		}
+		data, err := json.Marshal(&spec)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		contentType := runtime.NegotiateContentType(r.Header.Get("Accept"),
+			"application/json", "application/yaml", "application/x-yaml", "text/yaml")
+		switch contentType {
+		case "application/yaml", "application/x-yaml", "text/yaml":
+			var v interface{}
+			if err := yaml.Unmarshal(data, &v); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			if data, err = yaml.Marshal(v); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		default:
+			contentType = "application/json"
+		}
+
+		w.Header().Set("Content-Type", contentType)
+		w.Write(data)
+	})
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
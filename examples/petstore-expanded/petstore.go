@@ -28,7 +28,9 @@ func main() {
 	}
 
 	// Clear out the servers array in the swagger spec, that skips validating
-	// that server names match. We don't know how this thing will be run.
+	// that server names match. We don't know how this thing will be run, so
+	// the handlers are served at the root rather than at the path of the
+	// server.
 	swagger.Servers = nil
 
 	// Create an instance of our handler which satisfies the generated interface
@@ -42,7 +44,7 @@ func main() {
 	r.Use(middleware.OAPIValidator(swagger))
 
 	// We now register our petStore above as the handler for the interface
-	api.Handler(petStore, api.WithRouter(r))
+	api.Handler(petStore, api.WithRouter(r), api.WithServerBaseURL("/"))
 
 	s := &http.Server{
 		Handler: r,
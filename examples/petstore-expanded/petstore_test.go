@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -14,6 +15,7 @@ import (
 	"github.com/discord-gophers/goapi-gen/examples/petstore-expanded/api"
 	"github.com/discord-gophers/goapi-gen/internal/testutil"
 	"github.com/discord-gophers/goapi-gen/middleware"
+	"github.com/discord-gophers/goapi-gen/specui"
 )
 
 func doGet(t *testing.T, mux *chi.Mux, url string) *httptest.ResponseRecorder {
@@ -29,7 +31,9 @@ func TestPetStore(t *testing.T) {
 	require.NoError(t, err)
 
 	// Clear out the servers array in the swagger spec, that skips validating
-	// that server names match. We don't know how this thing will be run.
+	// that server names match. We don't know how this thing will be run, so
+	// the handlers are served at the root rather than at the path of the
+	// server.
 	swagger.Servers = nil
 
 	// This is how you set up a basic chi router
@@ -40,7 +44,7 @@ func TestPetStore(t *testing.T) {
 	r.Use(middleware.OAPIValidator(swagger))
 
 	store := api.NewPetStore()
-	api.Handler(store, api.WithRouter(r))
+	api.Handler(store, api.WithRouter(r), api.WithServerBaseURL("/"))
 
 	t.Run("Add pet", func(t *testing.T) {
 		tag := "TagOfSpot"
@@ -168,3 +172,52 @@ func TestPetStore(t *testing.T) {
 		assert.Equal(t, 0, len(petList))
 	})
 }
+
+func TestPetStoreSpec(t *testing.T) {
+	r := api.Handler(api.NewPetStore(),
+		api.WithServerBaseURL("/api"),
+		api.WithSpecRoute("/openapi.json"),
+		api.WithSpecUI("/docs", specui.SwaggerUI),
+	)
+
+	t.Run("JSON", func(t *testing.T) {
+		rr := testutil.NewRequest().Get("/api/openapi.json").WithHost("example.com").GoWithHTTPHandler(t, r).Recorder
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+
+		swagger, err := openapi3.NewLoader().LoadFromData(rr.Body.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, "Swagger Petstore", swagger.Info.Title)
+		require.Len(t, swagger.Servers, 1)
+		assert.Equal(t, "http://example.com/api", swagger.Servers[0].URL)
+	})
+
+	t.Run("YAML", func(t *testing.T) {
+		rr := testutil.NewRequest().Get("/api/openapi.json").
+			WithHost("example.com").
+			WithHeader("X-Forwarded-Proto", "https").
+			WithAccept("application/json;q=0.5, application/yaml").
+			GoWithHTTPHandler(t, r).Recorder
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/yaml", rr.Header().Get("Content-Type"))
+		assert.Contains(t, rr.Body.String(), "openapi: 3.0.0")
+
+		swagger, err := openapi3.NewLoader().LoadFromData(rr.Body.Bytes())
+		require.NoError(t, err)
+		require.Len(t, swagger.Servers, 1)
+		assert.Equal(t, "https://example.com/api", swagger.Servers[0].URL)
+	})
+
+	t.Run("UI", func(t *testing.T) {
+		rr := testutil.NewRequest().Get("/api/docs/").GoWithHTTPHandler(t, r).Recorder
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `url: "/api/openapi.json"`)
+
+		rr = testutil.NewRequest().Get("/api/docs/swagger-ui.css").GoWithHTTPHandler(t, r).Recorder
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		rr = testutil.NewRequest().Get("/api/docs").GoWithHTTPHandler(t, r).Recorder
+		assert.Equal(t, http.StatusMovedPermanently, rr.Code)
+		assert.Equal(t, "/api/docs/", rr.Header().Get("Location"))
+	})
+}
@@ -0,0 +1,458 @@
+// Package collecterrors provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/discord-gophers/goapi-gen version (devel) DO NOT EDIT.
+package collecterrors
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/discord-gophers/goapi-gen/runtime"
+	"github.com/go-chi/chi/v5"
+)
+
+// FindThingsParams defines parameters for FindThings.
+type FindThingsParams struct {
+	Limit    int   `json:"limit"`
+	Tags     []int `json:"tags,omitempty"`
+	XVersion *int  `json:"X-Version,omitempty"`
+	Session  int   `json:"session"`
+	Filter   *struct {
+		Name *string `json:"name,omitempty"`
+	} `json:"filter,omitempty"`
+}
+
+// Response is a common response struct for all the API calls.
+// A Response object may be instantiated via functions for specific operation responses.
+// It may also be instantiated directly, for the purpose of responding with a single status code.
+type Response struct {
+	body        interface{}
+	Code        int
+	contentType string
+	offers      []string
+	raw         bool
+}
+
+// Render writes the response. It sets the Content-Type header and status code
+// based on the response definition, and writes the body encoded for its content type.
+// Raw bodies, such as text/plain or image/png, are written as is.
+// Nothing is written if the body can't be encoded.
+//
+// Responses with several representations are written in the one which best
+// matches the Accept header of the request, or in the default content type
+// when none is acceptable.
+func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
+	contentType := resp.contentType
+	if accept := r.Header.Get("Accept"); accept != "" && len(resp.offers) > 0 {
+		offers := append([]string{contentType}, resp.offers...)
+		if ct := runtime.NegotiateContentType(accept, offers...); ct != "" {
+			contentType = ct
+		}
+	}
+
+	if resp.raw {
+		w.Header().Set("Content-Type", contentType)
+		w.WriteHeader(resp.Code)
+		switch body := resp.body.(type) {
+		case string:
+			io.WriteString(w, body)
+		case []byte:
+			w.Write(body)
+		case io.Reader:
+			if c, ok := body.(io.Closer); ok {
+				defer c.Close()
+			}
+			io.Copy(w, body)
+		}
+		return nil
+	}
+
+	var data []byte
+	var err error
+	switch {
+	case strings.Contains(contentType, "xml"):
+		data, err = xml.Marshal(resp)
+		data = append([]byte(xml.Header), data...)
+	case strings.Contains(contentType, "yaml"):
+		data, err = runtime.MarshalYAML(resp.body)
+	default:
+		data, err = json.Marshal(resp.body)
+	}
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(resp.Code)
+	w.Write(data)
+	return nil
+}
+
+// Status is a builder method to override the default status code for a response.
+func (resp *Response) Status(code int) *Response {
+	resp.Code = code
+	return resp
+}
+
+// ContentType is a builder method to override the default content type for a response.
+// The default content type of a response with several representations is
+// used when the Accept header of the request allows none of them.
+func (resp *Response) ContentType(contentType string) *Response {
+	resp.contentType = contentType
+	return resp
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// This is used to only marshal the body of the response.
+func (resp *Response) MarshalJSON() ([]byte, error) {
+	return json.Marshal(resp.body)
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+// This is used to only marshal the body of the response.
+func (resp *Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.Encode(resp.body)
+}
+
+// defaultBaseURL is the base URL of the server, the path of the URL of the
+// first server of the specification.
+const defaultBaseURL = "/"
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /things/{id})
+	FindThings(w http.ResponseWriter, r *http.Request, id int, params FindThingsParams) *Response
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler          ServerInterface
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	Observer         runtime.Observer
+}
+
+// FindThings operation middleware
+func (siw *ServerInterfaceWrapper) FindThings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "FindThings", "/things/{id}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
+	// Errors of all the parameters failing to bind are passed to the error handler at once.
+	var paramErrors ParameterErrors
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	if raw, err := runtime.StyledParameterValue("simple", false, "id", chi.URLParam(r, "id")); err != nil {
+		paramErrors = append(paramErrors, &InvalidParamFormatError{err, "id"})
+	} else if id, err = runtime.ParseInt(raw); err != nil {
+		paramErrors = append(paramErrors, &InvalidParamFormatError{err, "id"})
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindThingsParams
+
+	query := r.URL.Query()
+
+	// ------------- Required query parameter "limit" -------------
+
+	if raw, found, err := runtime.QueryParameterValue("form", true, "limit", query); err != nil {
+		err = fmt.Errorf("invalid format for parameter limit: %w", err)
+		paramErrors = append(paramErrors, &RequiredParamError{err, "limit"})
+	} else if found {
+		if value, err := runtime.ParseInt(raw); err != nil {
+			err = fmt.Errorf("invalid format for parameter limit: %w", err)
+			paramErrors = append(paramErrors, &RequiredParamError{err, "limit"})
+		} else {
+			params.Limit = value
+		}
+	} else {
+		paramErrors = append(paramErrors, &RequiredParamError{paramName: "limit"})
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	if raw, found, err := runtime.QueryParameterValues("form", true, "tags", query); err != nil {
+		err = fmt.Errorf("invalid format for parameter tags: %w", err)
+		paramErrors = append(paramErrors, &InvalidParamFormatError{err, "tags"})
+	} else if found {
+		if value, err := runtime.ParseIntSlice(raw); err != nil {
+			err = fmt.Errorf("invalid format for parameter tags: %w", err)
+			paramErrors = append(paramErrors, &InvalidParamFormatError{err, "tags"})
+		} else {
+			params.Tags = value
+		}
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Version" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Version")]; found {
+		var XVersion int
+		n := len(valueList)
+		if n != 1 {
+			paramErrors = append(paramErrors, &TooManyValuesForParamError{n, "X-Version"})
+		} else {
+
+			if raw, err := runtime.StyledParameterValueWithLocation("simple", false, "X-Version", runtime.ParamLocationHeader, valueList[0]); err != nil {
+				paramErrors = append(paramErrors, &InvalidParamFormatError{err, "X-Version"})
+			} else if XVersion, err = runtime.ParseInt(raw); err != nil {
+				paramErrors = append(paramErrors, &InvalidParamFormatError{err, "X-Version"})
+			}
+
+			params.XVersion = &XVersion
+
+		}
+
+	}
+
+	if cookie, err := r.Cookie("session"); err == nil {
+		var value int
+		if raw, err := runtime.StyledParameterValue("simple", true, "session", cookie.Value); err != nil {
+			paramErrors = append(paramErrors, &InvalidParamFormatError{err, "session"})
+		} else if value, err = runtime.ParseInt(raw); err != nil {
+			paramErrors = append(paramErrors, &InvalidParamFormatError{err, "session"})
+		}
+		params.Session = value
+
+	} else {
+		paramErrors = append(paramErrors, &RequiredParamError{err, "session"})
+	}
+
+	if cookie, err := r.Cookie("filter"); err == nil {
+		var value struct {
+			Name *string `json:"name,omitempty"`
+		}
+		var decoded string
+		decoded, err := url.QueryUnescape(cookie.Value)
+		if err != nil {
+			paramErrors = append(paramErrors, &UnescapedCookieParamError{err, "filter"})
+		} else if err := json.Unmarshal([]byte(decoded), &value); err != nil {
+			paramErrors = append(paramErrors, &UnmarshalingParamError{err, "filter"})
+		}
+
+		params.Filter = &value
+
+	}
+
+	if len(paramErrors) > 0 {
+		siw.ErrorHandlerFunc(w, r, paramErrors)
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.FindThings(w, r, id, params)
+		if resp != nil {
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	err       error
+	paramName string
+}
+
+// Error implements error.
+func (err UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter %s: %v", err.paramName, err.err)
+}
+
+func (err UnescapedCookieParamError) Unwrap() error { return err.err }
+
+type UnmarshalingParamError struct {
+	err       error
+	paramName string
+}
+
+// Error implements error.
+func (err UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("error unmarshaling parameter %s as JSON: %v", err.paramName, err.err)
+}
+
+func (err UnmarshalingParamError) Unwrap() error { return err.err }
+
+type RequiredParamError struct {
+	err       error
+	paramName string
+}
+
+// Error implements error.
+func (err RequiredParamError) Error() string {
+	if err.err == nil {
+		return fmt.Sprintf("query parameter %s is required, but not found", err.paramName)
+	} else {
+		return fmt.Sprintf("query parameter %s is required, but errored: %s", err.paramName, err.err)
+	}
+}
+
+func (err RequiredParamError) Unwrap() error { return err.err }
+
+type RequiredHeaderError struct {
+	paramName string
+}
+
+// Error implements error.
+func (err RequiredHeaderError) Error() string {
+	return fmt.Sprintf("header parameter %s is required, but not found", err.paramName)
+}
+
+type InvalidParamFormatError struct {
+	err       error
+	paramName string
+}
+
+// Error implements error.
+func (err InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("invalid format for parameter %s: %v", err.paramName, err.err)
+}
+
+func (err InvalidParamFormatError) Unwrap() error { return err.err }
+
+type TooManyValuesForParamError struct {
+	NumValues int
+	paramName string
+}
+
+// Error implements error.
+func (err TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("expected one value for %s, got %d", err.paramName, err.NumValues)
+}
+
+// ParameterName is an interface that is implemented by error types that are
+// relevant to a specific parameter.
+type ParameterError interface {
+	error
+	// ParamName is the name of the parameter that the error is referring to.
+	ParamName() string
+}
+
+// ParameterErrors is passed to the ErrorHandlerFunc with the errors of all the
+// parameters of a request which failed to bind.
+type ParameterErrors []ParameterError
+
+// Error implements error.
+func (errs ParameterErrors) Error() string {
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the errors of the parameters.
+func (errs ParameterErrors) Unwrap() []error {
+	unwrapped := make([]error, len(errs))
+	for i, err := range errs {
+		unwrapped[i] = err
+	}
+	return unwrapped
+}
+
+func (err UnescapedCookieParamError) ParamName() string  { return err.paramName }
+func (err UnmarshalingParamError) ParamName() string     { return err.paramName }
+func (err RequiredParamError) ParamName() string         { return err.paramName }
+func (err RequiredHeaderError) ParamName() string        { return err.paramName }
+func (err InvalidParamFormatError) ParamName() string    { return err.paramName }
+func (err TooManyValuesForParamError) ParamName() string { return err.paramName }
+
+type ServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	Observer         runtime.Observer
+}
+
+type ServerOption func(*ServerOptions)
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+// It panics if the options are invalid, such as when a tagged middleware is missing.
+func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
+	h, err := NewHandler(si, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// NewHandler creates http.Handler with routing matching OpenAPI spec.
+// An error is returned if the options are invalid, such as when a tagged middleware is missing.
+func NewHandler(si ServerInterface, opts ...ServerOption) (http.Handler, error) {
+	options := &ServerOptions{
+		BaseURL:    defaultBaseURL,
+		BaseRouter: chi.NewRouter(),
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			status := http.StatusBadRequest
+			http.Error(w, err.Error(), status)
+		},
+	}
+
+	for _, f := range opts {
+		f(options)
+	}
+
+	r := options.BaseRouter
+	wrapper := ServerInterfaceWrapper{
+		Handler:          si,
+		ErrorHandlerFunc: options.ErrorHandlerFunc,
+		Observer:         options.Observer,
+	}
+
+	// Errors, such as parameter binding errors, are recorded on the observed
+	// call of the operation.
+	wrapper.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+		runtime.SetCallError(r.Context(), err)
+		options.ErrorHandlerFunc(w, r, err)
+	}
+
+	r.Route(options.BaseURL, func(r chi.Router) {
+		r.Get("/things/{id}", wrapper.FindThings)
+	})
+	return r, nil
+}
+
+func WithRouter(r chi.Router) ServerOption {
+	return func(s *ServerOptions) {
+		s.BaseRouter = r
+	}
+}
+
+func WithServerBaseURL(url string) ServerOption {
+	return func(s *ServerOptions) {
+		s.BaseURL = url
+	}
+}
+
+func WithErrorHandler(handler func(w http.ResponseWriter, r *http.Request, err error)) ServerOption {
+	return func(s *ServerOptions) {
+		s.ErrorHandlerFunc = handler
+	}
+}
+
+// WithObserver reports each call of an operation to o once it returns, with
+// its operation ID, method, route template, status code, response size,
+// duration and the error passed to the error handler, if any.
+func WithObserver(o runtime.Observer) ServerOption {
+	return func(s *ServerOptions) {
+		s.Observer = o
+	}
+}
@@ -0,0 +1,63 @@
+package collecterrors
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type server struct {
+	params *FindThingsParams
+}
+
+func (s *server) FindThings(w http.ResponseWriter, r *http.Request, id int, params FindThingsParams) *Response {
+	s.params = &params
+	return &Response{Code: http.StatusNoContent}
+}
+
+func TestCollectErrors(t *testing.T) {
+	var s server
+	var paramErrs ParameterErrors
+	h := Handler(&s, WithErrorHandler(func(w http.ResponseWriter, r *http.Request, err error) {
+		require.True(t, errors.As(err, &paramErrs))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "/things/abc?limit=ten&tags=1&tags=two", nil)
+	r.Header.Add("X-Version", "1")
+	r.Header.Add("X-Version", "2")
+	r.AddCookie(&http.Cookie{Name: "filter", Value: "%zz"})
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, r)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Nil(t, s.params)
+
+	var names []string
+	for _, err := range paramErrs {
+		names = append(names, err.ParamName())
+	}
+	assert.Equal(t, []string{"id", "limit", "tags", "X-Version", "session", "filter"}, names)
+	assert.IsType(t, &InvalidParamFormatError{}, paramErrs[0])
+	assert.IsType(t, &TooManyValuesForParamError{}, paramErrs[3])
+	assert.IsType(t, &UnescapedCookieParamError{}, paramErrs[5])
+	assert.Len(t, paramErrs.Unwrap(), len(paramErrs))
+	assert.Contains(t, rr.Body.String(), "invalid format for parameter id")
+	assert.Contains(t, rr.Body.String(), "; expected one value for X-Version, got 2;")
+
+	r = httptest.NewRequest(http.MethodGet, "/things/1?limit=10&tags=1&tags=2", nil)
+	r.Header.Set("X-Version", "3")
+	r.AddCookie(&http.Cookie{Name: "session", Value: "42"})
+	rr = httptest.NewRecorder()
+	h.ServeHTTP(rr, r)
+
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+	require.NotNil(t, s.params)
+	assert.Equal(t, 10, s.params.Limit)
+	assert.Equal(t, []int{1, 2}, s.params.Tags)
+	assert.Equal(t, 42, s.params.Session)
+}
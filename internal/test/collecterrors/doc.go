@@ -0,0 +1,3 @@
+package collecterrors
+
+//go:generate go run github.com/discord-gophers/goapi-gen --generate=types,server,collect-errors --package=collecterrors -o collecterrors.gen.go collecterrors.yaml
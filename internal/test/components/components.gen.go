@@ -9,16 +9,19 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
 
+	"gopkg.in/yaml.v3"
+
 	"github.com/discord-gophers/goapi-gen/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/render"
 )
 
 // Has additional properties of type int
@@ -102,6 +105,9 @@ type RequestBody struct {
 	Field SchemaObject `json:"Field"`
 }
 
+// EnsureEverythingIsReferencedTextBody defines parameters for EnsureEverythingIsReferenced.
+type EnsureEverythingIsReferencedTextBody string
+
 // ParamsWithAddPropsParams_P1 defines parameters for ParamsWithAddProps.
 type ParamsWithAddPropsParams_P1 struct {
 	AdditionalProperties map[string]interface{} `json:"-"`
@@ -144,6 +150,9 @@ func (EnsureEverythingIsReferencedJSONRequestBody) Bind(*http.Request) error {
 	return nil
 }
 
+// EnsureEverythingIsReferencedTextRequestBody defines body for EnsureEverythingIsReferenced for text/plain ContentType.
+type EnsureEverythingIsReferencedTextRequestBody EnsureEverythingIsReferencedTextBody
+
 // BodyWithAddPropsJSONRequestBody defines body for BodyWithAddProps for application/json ContentType.
 type BodyWithAddPropsJSONRequestBody BodyWithAddPropsJSONBody
 
@@ -159,13 +168,62 @@ type Response struct {
 	body        interface{}
 	Code        int
 	contentType string
+	offers      []string
+	raw         bool
 }
 
-// Render implements the render.Renderer interface. It sets the Content-Type header
-// and status code based on the response definition.
+// Render writes the response. It sets the Content-Type header and status code
+// based on the response definition, and writes the body encoded for its content type.
+// Raw bodies, such as text/plain or image/png, are written as is.
+// Nothing is written if the body can't be encoded.
+//
+// Responses with several representations are written in the one which best
+// matches the Accept header of the request, or in the default content type
+// when none is acceptable.
 func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
-	w.Header().Set("Content-Type", resp.contentType)
-	render.Status(r, resp.Code)
+	contentType := resp.contentType
+	if accept := r.Header.Get("Accept"); accept != "" && len(resp.offers) > 0 {
+		offers := append([]string{contentType}, resp.offers...)
+		if ct := runtime.NegotiateContentType(accept, offers...); ct != "" {
+			contentType = ct
+		}
+	}
+
+	if resp.raw {
+		w.Header().Set("Content-Type", contentType)
+		w.WriteHeader(resp.Code)
+		switch body := resp.body.(type) {
+		case string:
+			io.WriteString(w, body)
+		case []byte:
+			w.Write(body)
+		case io.Reader:
+			if c, ok := body.(io.Closer); ok {
+				defer c.Close()
+			}
+			io.Copy(w, body)
+		}
+		return nil
+	}
+
+	var data []byte
+	var err error
+	switch {
+	case strings.Contains(contentType, "xml"):
+		data, err = xml.Marshal(resp)
+		data = append([]byte(xml.Header), data...)
+	case strings.Contains(contentType, "yaml"):
+		data, err = runtime.MarshalYAML(resp.body)
+	default:
+		data, err = json.Marshal(resp.body)
+	}
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(resp.Code)
+	w.Write(data)
 	return nil
 }
 
@@ -176,6 +234,8 @@ func (resp *Response) Status(code int) *Response {
 }
 
 // ContentType is a builder method to override the default content type for a response.
+// The default content type of a response with several representations is
+// used when the Accept header of the request allows none of them.
 func (resp *Response) ContentType(contentType string) *Response {
 	resp.contentType = contentType
 	return resp
@@ -240,6 +300,17 @@ func EnsureEverythingIsReferencedJSONDefaultResponse(body struct {
 	}
 }
 
+// EnsureEverythingIsReferencedTextPlainDefaultResponse is a constructor method for a EnsureEverythingIsReferenced response.
+// A *Response is returned with the configured status code and content type from the spec.
+func EnsureEverythingIsReferencedTextPlainDefaultResponse(body string) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "text/plain",
+		raw:         true,
+	}
+}
+
 // Getter for additional properties for ParamsWithAddPropsParams_P1. Returns the specified
 // element and whether it was found
 func (a ParamsWithAddPropsParams_P1) Get(fieldName string) (value interface{}, found bool) {
@@ -836,6 +907,10 @@ func (a AdditionalPropertiesObject5) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
+// defaultBaseURL is the base URL of the server, the path of the URL of the
+// first server of the specification.
+const defaultBaseURL = "/"
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
@@ -853,17 +928,27 @@ type ServerInterface interface {
 type ServerInterfaceWrapper struct {
 	Handler          ServerInterface
 	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	Observer         runtime.Observer
 }
 
 // EnsureEverythingIsReferenced operation middleware
 func (siw *ServerInterfaceWrapper) EnsureEverythingIsReferenced(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "EnsureEverythingIsReferenced", "/ensure-everything-is-referenced")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.EnsureEverythingIsReferenced(w, r)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -877,12 +962,21 @@ func (siw *ServerInterfaceWrapper) EnsureEverythingIsReferenced(w http.ResponseW
 func (siw *ServerInterfaceWrapper) ParamsWithAddProps(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "ParamsWithAddProps", "/params_with_add_props")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params ParamsWithAddPropsParams
 
+	query := r.URL.Query()
+
 	// ------------- Required query parameter "p1" -------------
 
-	if err := runtime.BindQueryParameter("simple", true, true, "p1", r.URL.Query(), &params.P1); err != nil {
+	if err := runtime.BindQueryParameter("simple", true, true, "p1", query, &params.P1); err != nil {
 		err = fmt.Errorf("invalid format for parameter p1: %w", err)
 		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "p1"})
 		return
@@ -890,7 +984,7 @@ func (siw *ServerInterfaceWrapper) ParamsWithAddProps(w http.ResponseWriter, r *
 
 	// ------------- Required query parameter "p2" -------------
 
-	if err := runtime.BindQueryParameter("form", true, true, "p2", r.URL.Query(), &params.P2); err != nil {
+	if err := runtime.BindQueryParameter("form", true, true, "p2", query, &params.P2); err != nil {
 		err = fmt.Errorf("invalid format for parameter p2: %w", err)
 		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "p2"})
 		return
@@ -899,8 +993,10 @@ func (siw *ServerInterfaceWrapper) ParamsWithAddProps(w http.ResponseWriter, r *
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.ParamsWithAddProps(w, r, params)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -914,11 +1010,20 @@ func (siw *ServerInterfaceWrapper) ParamsWithAddProps(w http.ResponseWriter, r *
 func (siw *ServerInterfaceWrapper) BodyWithAddProps(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "BodyWithAddProps", "/params_with_add_props")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.BodyWithAddProps(w, r)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -1018,17 +1123,33 @@ type ServerOptions struct {
 	BaseURL          string
 	BaseRouter       chi.Router
 	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	Observer         runtime.Observer
+	SpecRoute        string
+	SpecUIRoute      string
+	SpecUI           func(specURL string) http.Handler
 }
 
 type ServerOption func(*ServerOptions)
 
 // Handler creates http.Handler with routing matching OpenAPI spec.
+// It panics if the options are invalid, such as when a tagged middleware is missing.
 func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
+	h, err := NewHandler(si, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// NewHandler creates http.Handler with routing matching OpenAPI spec.
+// An error is returned if the options are invalid, such as when a tagged middleware is missing.
+func NewHandler(si ServerInterface, opts ...ServerOption) (http.Handler, error) {
 	options := &ServerOptions{
-		BaseURL:    "/",
+		BaseURL:    defaultBaseURL,
 		BaseRouter: chi.NewRouter(),
 		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			status := http.StatusBadRequest
+			http.Error(w, err.Error(), status)
 		},
 	}
 
@@ -1040,14 +1161,35 @@ func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
 	wrapper := ServerInterfaceWrapper{
 		Handler:          si,
 		ErrorHandlerFunc: options.ErrorHandlerFunc,
+		Observer:         options.Observer,
+	}
+
+	// Errors, such as parameter binding errors, are recorded on the observed
+	// call of the operation.
+	wrapper.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+		runtime.SetCallError(r.Context(), err)
+		options.ErrorHandlerFunc(w, r, err)
+	}
+
+	if options.SpecUI != nil && options.SpecRoute == "" {
+		return nil, errors.New("goapi-gen: WithSpecUI requires WithSpecRoute")
 	}
 
 	r.Route(options.BaseURL, func(r chi.Router) {
 		r.Get("/ensure-everything-is-referenced", wrapper.EnsureEverythingIsReferenced)
 		r.Get("/params_with_add_props", wrapper.ParamsWithAddProps)
 		r.Post("/params_with_add_props", wrapper.BodyWithAddProps)
+
+		if options.SpecRoute != "" {
+			r.Method(http.MethodGet, options.SpecRoute, SpecHandler(opts...))
+		}
+		if options.SpecUI != nil {
+			ui := options.SpecUI(path.Join(options.BaseURL, options.SpecRoute))
+			r.Method(http.MethodGet, options.SpecUIRoute, ui)
+			r.Method(http.MethodGet, strings.TrimSuffix(options.SpecUIRoute, "/")+"/*", ui)
+		}
 	})
-	return r
+	return r, nil
 }
 
 func WithRouter(r chi.Router) ServerOption {
@@ -1068,6 +1210,93 @@ func WithErrorHandler(handler func(w http.ResponseWriter, r *http.Request, err e
 	}
 }
 
+// WithObserver reports each call of an operation to o once it returns, with
+// its operation ID, method, route template, status code, response size,
+// duration and the error passed to the error handler, if any.
+func WithObserver(o runtime.Observer) ServerOption {
+	return func(s *ServerOptions) {
+		s.Observer = o
+	}
+}
+
+// WithSpecRoute serves the embedded OpenAPI specification at pattern, relative
+// to the base URL. See SpecHandler.
+func WithSpecRoute(pattern string) ServerOption {
+	return func(s *ServerOptions) {
+		s.SpecRoute = pattern
+	}
+}
+
+// WithSpecUI serves an API explorer for the specification served by
+// WithSpecRoute at pattern, relative to the base URL. ui creates the
+// explorer's handler from the URL of the specification, for example
+// specui.SwaggerUI.
+func WithSpecUI(pattern string, ui func(specURL string) http.Handler) ServerOption {
+	return func(s *ServerOptions) {
+		s.SpecUIRoute = pattern
+		s.SpecUI = ui
+	}
+}
+
+// SpecHandler returns an http.Handler serving the embedded OpenAPI
+// specification as JSON, or as YAML if the Accept header prefers it. The
+// servers of the specification are replaced by the request's host and the
+// base URL of opts.
+func SpecHandler(opts ...ServerOption) http.Handler {
+	options := &ServerOptions{
+		BaseURL: defaultBaseURL,
+	}
+	for _, f := range opts {
+		f(options)
+	}
+
+	swagger, loadErr := GetSwagger()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if loadErr != nil {
+			http.Error(w, loadErr.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		scheme := "http"
+		if r.TLS != nil {
+			scheme = "https"
+		}
+		if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+			scheme = proto
+		}
+
+		spec := *swagger
+		spec.Servers = openapi3.Servers{
+			{URL: scheme + "://" + r.Host + strings.TrimSuffix(options.BaseURL, "/")},
+		}
+		data, err := json.Marshal(&spec)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		contentType := runtime.NegotiateContentType(r.Header.Get("Accept"),
+			"application/json", "application/yaml", "application/x-yaml", "text/yaml")
+		switch contentType {
+		case "application/yaml", "application/x-yaml", "text/yaml":
+			var v interface{}
+			if err := yaml.Unmarshal(data, &v); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			if data, err = yaml.Marshal(v); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		default:
+			contentType = "application/json"
+		}
+
+		w.Header().Set("Content-Type", contentType)
+		w.Write(data)
+	})
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
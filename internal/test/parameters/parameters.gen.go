@@ -9,16 +9,19 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
 
+	"gopkg.in/yaml.v3"
+
 	"github.com/discord-gophers/goapi-gen/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/render"
 )
 
 // ComplexObject defines model for ComplexObject.
@@ -94,6 +97,18 @@ type GetDeepObjectParams struct {
 	DeepObj ComplexObject `json:"deepObj"`
 }
 
+// GetQueryDelimitedParams defines parameters for GetQueryDelimited.
+type GetQueryDelimitedParams struct {
+	// pipe delimited array
+	Pa []string `json:"pa,omitempty"`
+
+	// space delimited array
+	Sa []string `json:"sa,omitempty"`
+
+	// pipe delimited object
+	Po *Object `json:"po,omitempty"`
+}
+
 // GetQueryFormParams defines parameters for GetQueryForm.
 type GetQueryFormParams struct {
 	// exploded array
@@ -131,13 +146,62 @@ type Response struct {
 	body        interface{}
 	Code        int
 	contentType string
+	offers      []string
+	raw         bool
 }
 
-// Render implements the render.Renderer interface. It sets the Content-Type header
-// and status code based on the response definition.
+// Render writes the response. It sets the Content-Type header and status code
+// based on the response definition, and writes the body encoded for its content type.
+// Raw bodies, such as text/plain or image/png, are written as is.
+// Nothing is written if the body can't be encoded.
+//
+// Responses with several representations are written in the one which best
+// matches the Accept header of the request, or in the default content type
+// when none is acceptable.
 func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
-	w.Header().Set("Content-Type", resp.contentType)
-	render.Status(r, resp.Code)
+	contentType := resp.contentType
+	if accept := r.Header.Get("Accept"); accept != "" && len(resp.offers) > 0 {
+		offers := append([]string{contentType}, resp.offers...)
+		if ct := runtime.NegotiateContentType(accept, offers...); ct != "" {
+			contentType = ct
+		}
+	}
+
+	if resp.raw {
+		w.Header().Set("Content-Type", contentType)
+		w.WriteHeader(resp.Code)
+		switch body := resp.body.(type) {
+		case string:
+			io.WriteString(w, body)
+		case []byte:
+			w.Write(body)
+		case io.Reader:
+			if c, ok := body.(io.Closer); ok {
+				defer c.Close()
+			}
+			io.Copy(w, body)
+		}
+		return nil
+	}
+
+	var data []byte
+	var err error
+	switch {
+	case strings.Contains(contentType, "xml"):
+		data, err = xml.Marshal(resp)
+		data = append([]byte(xml.Header), data...)
+	case strings.Contains(contentType, "yaml"):
+		data, err = runtime.MarshalYAML(resp.body)
+	default:
+		data, err = json.Marshal(resp.body)
+	}
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(resp.Code)
+	w.Write(data)
 	return nil
 }
 
@@ -148,6 +212,8 @@ func (resp *Response) Status(code int) *Response {
 }
 
 // ContentType is a builder method to override the default content type for a response.
+// The default content type of a response with several representations is
+// used when the Accept header of the request allows none of them.
 func (resp *Response) ContentType(contentType string) *Response {
 	resp.contentType = contentType
 	return resp
@@ -165,6 +231,214 @@ func (resp *Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	return e.Encode(resp.body)
 }
 
+// GetContentObjectTextPlain200Response is a constructor method for a GetContentObject response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetContentObjectTextPlain200Response(body string) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "text/plain",
+		raw:         true,
+	}
+}
+
+// GetLabelExplodeArrayTextPlain200Response is a constructor method for a GetLabelExplodeArray response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetLabelExplodeArrayTextPlain200Response(body string) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "text/plain",
+		raw:         true,
+	}
+}
+
+// GetLabelExplodeObjectTextPlain200Response is a constructor method for a GetLabelExplodeObject response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetLabelExplodeObjectTextPlain200Response(body string) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "text/plain",
+		raw:         true,
+	}
+}
+
+// GetLabelNoExplodeArrayTextPlain200Response is a constructor method for a GetLabelNoExplodeArray response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetLabelNoExplodeArrayTextPlain200Response(body string) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "text/plain",
+		raw:         true,
+	}
+}
+
+// GetLabelNoExplodeObjectTextPlain200Response is a constructor method for a GetLabelNoExplodeObject response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetLabelNoExplodeObjectTextPlain200Response(body string) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "text/plain",
+		raw:         true,
+	}
+}
+
+// GetMatrixExplodeArrayTextPlain200Response is a constructor method for a GetMatrixExplodeArray response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetMatrixExplodeArrayTextPlain200Response(body string) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "text/plain",
+		raw:         true,
+	}
+}
+
+// GetMatrixExplodeObjectTextPlain200Response is a constructor method for a GetMatrixExplodeObject response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetMatrixExplodeObjectTextPlain200Response(body string) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "text/plain",
+		raw:         true,
+	}
+}
+
+// GetMatrixNoExplodeArrayTextPlain200Response is a constructor method for a GetMatrixNoExplodeArray response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetMatrixNoExplodeArrayTextPlain200Response(body string) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "text/plain",
+		raw:         true,
+	}
+}
+
+// GetMatrixNoExplodeObjectTextPlain200Response is a constructor method for a GetMatrixNoExplodeObject response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetMatrixNoExplodeObjectTextPlain200Response(body string) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "text/plain",
+		raw:         true,
+	}
+}
+
+// GetPassThroughTextPlain200Response is a constructor method for a GetPassThrough response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetPassThroughTextPlain200Response(body string) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "text/plain",
+		raw:         true,
+	}
+}
+
+// GetQueryDelimitedTextPlain200Response is a constructor method for a GetQueryDelimited response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetQueryDelimitedTextPlain200Response(body string) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "text/plain",
+		raw:         true,
+	}
+}
+
+// GetQueryFormTextPlain200Response is a constructor method for a GetQueryForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetQueryFormTextPlain200Response(body string) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "text/plain",
+		raw:         true,
+	}
+}
+
+// GetSimpleExplodeArrayTextPlain200Response is a constructor method for a GetSimpleExplodeArray response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSimpleExplodeArrayTextPlain200Response(body string) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "text/plain",
+		raw:         true,
+	}
+}
+
+// GetSimpleExplodeObjectTextPlain200Response is a constructor method for a GetSimpleExplodeObject response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSimpleExplodeObjectTextPlain200Response(body string) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "text/plain",
+		raw:         true,
+	}
+}
+
+// GetSimpleNoExplodeArrayTextPlain200Response is a constructor method for a GetSimpleNoExplodeArray response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSimpleNoExplodeArrayTextPlain200Response(body string) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "text/plain",
+		raw:         true,
+	}
+}
+
+// GetSimpleNoExplodeObjectTextPlain200Response is a constructor method for a GetSimpleNoExplodeObject response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSimpleNoExplodeObjectTextPlain200Response(body string) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "text/plain",
+		raw:         true,
+	}
+}
+
+// GetSimplePrimitiveTextPlain200Response is a constructor method for a GetSimplePrimitive response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSimplePrimitiveTextPlain200Response(body string) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "text/plain",
+		raw:         true,
+	}
+}
+
+// GetStartingWithNumberTextPlain200Response is a constructor method for a GetStartingWithNumber response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetStartingWithNumberTextPlain200Response(body string) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "text/plain",
+		raw:         true,
+	}
+}
+
+// defaultBaseURL is the base URL of the server, the path of the URL of the
+// first server of the specification.
+const defaultBaseURL = "/"
+
+// ServerURL0 returns the URL of the server
+// http://example.com.
+func ServerURL0() string {
+	return "http://example.com"
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
@@ -207,6 +481,9 @@ type ServerInterface interface {
 	// (GET /queryDeepObject)
 	GetDeepObject(w http.ResponseWriter, r *http.Request, params GetDeepObjectParams) *Response
 
+	// (GET /queryDelimited)
+	GetQueryDelimited(w http.ResponseWriter, r *http.Request, params GetQueryDelimitedParams) *Response
+
 	// (GET /queryForm)
 	GetQueryForm(w http.ResponseWriter, r *http.Request, params GetQueryFormParams) *Response
 
@@ -233,12 +510,20 @@ type ServerInterface interface {
 type ServerInterfaceWrapper struct {
 	Handler          ServerInterface
 	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	Observer         runtime.Observer
 }
 
 // GetContentObject operation middleware
 func (siw *ServerInterfaceWrapper) GetContentObject(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetContentObject", "/contentObject/{param}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "param" -------------
 	var param ComplexObject
 
@@ -250,8 +535,10 @@ func (siw *ServerInterfaceWrapper) GetContentObject(w http.ResponseWriter, r *ht
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetContentObject(w, r, param)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -265,12 +552,22 @@ func (siw *ServerInterfaceWrapper) GetContentObject(w http.ResponseWriter, r *ht
 func (siw *ServerInterfaceWrapper) GetCookie(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetCookie", "/cookie")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetCookieParams
 
 	if cookie, err := r.Cookie("p"); err == nil {
 		var value int32
-		if err := runtime.BindStyledParameter("simple", false, "p", cookie.Value, &value); err != nil {
+		if raw, err := runtime.StyledParameterValue("simple", false, "p", cookie.Value); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "p"})
+			return
+		} else if value, err = runtime.ParseInt32(raw); err != nil {
 			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "p"})
 			return
 		}
@@ -280,7 +577,10 @@ func (siw *ServerInterfaceWrapper) GetCookie(w http.ResponseWriter, r *http.Requ
 
 	if cookie, err := r.Cookie("ep"); err == nil {
 		var value int32
-		if err := runtime.BindStyledParameter("simple", true, "ep", cookie.Value, &value); err != nil {
+		if raw, err := runtime.StyledParameterValue("simple", true, "ep", cookie.Value); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "ep"})
+			return
+		} else if value, err = runtime.ParseInt32(raw); err != nil {
 			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "ep"})
 			return
 		}
@@ -290,7 +590,10 @@ func (siw *ServerInterfaceWrapper) GetCookie(w http.ResponseWriter, r *http.Requ
 
 	if cookie, err := r.Cookie("ea"); err == nil {
 		var value []int32
-		if err := runtime.BindStyledParameter("simple", true, "ea", cookie.Value, &value); err != nil {
+		if raw, err := runtime.StyledParameterValues("simple", true, "ea", cookie.Value); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "ea"})
+			return
+		} else if value, err = runtime.ParseInt32Slice(raw); err != nil {
 			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "ea"})
 			return
 		}
@@ -300,7 +603,10 @@ func (siw *ServerInterfaceWrapper) GetCookie(w http.ResponseWriter, r *http.Requ
 
 	if cookie, err := r.Cookie("a"); err == nil {
 		var value []int32
-		if err := runtime.BindStyledParameter("simple", false, "a", cookie.Value, &value); err != nil {
+		if raw, err := runtime.StyledParameterValues("simple", false, "a", cookie.Value); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "a"})
+			return
+		} else if value, err = runtime.ParseInt32Slice(raw); err != nil {
 			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "a"})
 			return
 		}
@@ -349,9 +655,11 @@ func (siw *ServerInterfaceWrapper) GetCookie(w http.ResponseWriter, r *http.Requ
 
 	if cookie, err := r.Cookie("1s"); err == nil {
 		var value string
-		if err := runtime.BindStyledParameter("simple", true, "1s", cookie.Value, &value); err != nil {
+		if raw, err := runtime.StyledParameterValue("simple", true, "1s", cookie.Value); err != nil {
 			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "1s"})
 			return
+		} else {
+			value = raw
 		}
 		params.N1s = &value
 
@@ -360,8 +668,10 @@ func (siw *ServerInterfaceWrapper) GetCookie(w http.ResponseWriter, r *http.Requ
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetCookie(w, r, params)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -375,6 +685,13 @@ func (siw *ServerInterfaceWrapper) GetCookie(w http.ResponseWriter, r *http.Requ
 func (siw *ServerInterfaceWrapper) GetHeader(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetHeader", "/header")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetHeaderParams
 
@@ -389,7 +706,10 @@ func (siw *ServerInterfaceWrapper) GetHeader(w http.ResponseWriter, r *http.Requ
 			return
 		}
 
-		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Primitive", runtime.ParamLocationHeader, valueList[0], &XPrimitive); err != nil {
+		if raw, err := runtime.StyledParameterValueWithLocation("simple", false, "X-Primitive", runtime.ParamLocationHeader, valueList[0]); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Primitive"})
+			return
+		} else if XPrimitive, err = runtime.ParseInt32(raw); err != nil {
 			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Primitive"})
 			return
 		}
@@ -407,7 +727,10 @@ func (siw *ServerInterfaceWrapper) GetHeader(w http.ResponseWriter, r *http.Requ
 			return
 		}
 
-		if err := runtime.BindStyledParameterWithLocation("simple", true, "X-Primitive-Exploded", runtime.ParamLocationHeader, valueList[0], &XPrimitiveExploded); err != nil {
+		if raw, err := runtime.StyledParameterValueWithLocation("simple", true, "X-Primitive-Exploded", runtime.ParamLocationHeader, valueList[0]); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Primitive-Exploded"})
+			return
+		} else if XPrimitiveExploded, err = runtime.ParseInt32(raw); err != nil {
 			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Primitive-Exploded"})
 			return
 		}
@@ -419,13 +742,12 @@ func (siw *ServerInterfaceWrapper) GetHeader(w http.ResponseWriter, r *http.Requ
 	// ------------- Optional header parameter "X-Array-Exploded" -------------
 	if valueList, found := headers[http.CanonicalHeaderKey("X-Array-Exploded")]; found {
 		var XArrayExploded []int32
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Array-Exploded"})
-			return
-		}
+		// Repeated header lines are bound as one comma-separated list.
 
-		if err := runtime.BindStyledParameterWithLocation("simple", true, "X-Array-Exploded", runtime.ParamLocationHeader, valueList[0], &XArrayExploded); err != nil {
+		if raw, err := runtime.StyledParameterValuesWithLocation("simple", true, "X-Array-Exploded", runtime.ParamLocationHeader, runtime.JoinHeaderValues(valueList)); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Array-Exploded"})
+			return
+		} else if XArrayExploded, err = runtime.ParseInt32Slice(raw); err != nil {
 			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Array-Exploded"})
 			return
 		}
@@ -437,13 +759,12 @@ func (siw *ServerInterfaceWrapper) GetHeader(w http.ResponseWriter, r *http.Requ
 	// ------------- Optional header parameter "X-Array" -------------
 	if valueList, found := headers[http.CanonicalHeaderKey("X-Array")]; found {
 		var XArray []int32
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Array"})
-			return
-		}
+		// Repeated header lines are bound as one comma-separated list.
 
-		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Array", runtime.ParamLocationHeader, valueList[0], &XArray); err != nil {
+		if raw, err := runtime.StyledParameterValuesWithLocation("simple", false, "X-Array", runtime.ParamLocationHeader, runtime.JoinHeaderValues(valueList)); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Array"})
+			return
+		} else if XArray, err = runtime.ParseInt32Slice(raw); err != nil {
 			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Array"})
 			return
 		}
@@ -455,13 +776,9 @@ func (siw *ServerInterfaceWrapper) GetHeader(w http.ResponseWriter, r *http.Requ
 	// ------------- Optional header parameter "X-Object-Exploded" -------------
 	if valueList, found := headers[http.CanonicalHeaderKey("X-Object-Exploded")]; found {
 		var XObjectExploded Object
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Object-Exploded"})
-			return
-		}
+		// Repeated header lines are bound as one comma-separated list.
 
-		if err := runtime.BindStyledParameterWithLocation("simple", true, "X-Object-Exploded", runtime.ParamLocationHeader, valueList[0], &XObjectExploded); err != nil {
+		if err := runtime.BindStyledParameterWithLocation("simple", true, "X-Object-Exploded", runtime.ParamLocationHeader, runtime.JoinHeaderValues(valueList), &XObjectExploded); err != nil {
 			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Object-Exploded"})
 			return
 		}
@@ -473,13 +790,9 @@ func (siw *ServerInterfaceWrapper) GetHeader(w http.ResponseWriter, r *http.Requ
 	// ------------- Optional header parameter "X-Object" -------------
 	if valueList, found := headers[http.CanonicalHeaderKey("X-Object")]; found {
 		var XObject Object
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Object"})
-			return
-		}
+		// Repeated header lines are bound as one comma-separated list.
 
-		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Object", runtime.ParamLocationHeader, valueList[0], &XObject); err != nil {
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Object", runtime.ParamLocationHeader, runtime.JoinHeaderValues(valueList), &XObject); err != nil {
 			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Object"})
 			return
 		}
@@ -515,9 +828,11 @@ func (siw *ServerInterfaceWrapper) GetHeader(w http.ResponseWriter, r *http.Requ
 			return
 		}
 
-		if err := runtime.BindStyledParameterWithLocation("simple", false, "1-Starting-With-Number", runtime.ParamLocationHeader, valueList[0], &N1StartingWithNumber); err != nil {
+		if raw, err := runtime.StyledParameterValueWithLocation("simple", false, "1-Starting-With-Number", runtime.ParamLocationHeader, valueList[0]); err != nil {
 			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "1-Starting-With-Number"})
 			return
+		} else {
+			N1StartingWithNumber = raw
 		}
 
 		params.N1StartingWithNumber = &N1StartingWithNumber
@@ -527,8 +842,10 @@ func (siw *ServerInterfaceWrapper) GetHeader(w http.ResponseWriter, r *http.Requ
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetHeader(w, r, params)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -542,10 +859,20 @@ func (siw *ServerInterfaceWrapper) GetHeader(w http.ResponseWriter, r *http.Requ
 func (siw *ServerInterfaceWrapper) GetLabelExplodeArray(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetLabelExplodeArray", "/labelExplodeArray/{.param*}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "param" -------------
 	var param []int32
 
-	if err := runtime.BindStyledParameter("label", true, "param", chi.URLParam(r, "param"), &param); err != nil {
+	if raw, err := runtime.StyledParameterValues("label", true, "param", chi.URLParam(r, "param")); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "param"})
+		return
+	} else if param, err = runtime.ParseInt32Slice(raw); err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "param"})
 		return
 	}
@@ -553,8 +880,10 @@ func (siw *ServerInterfaceWrapper) GetLabelExplodeArray(w http.ResponseWriter, r
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetLabelExplodeArray(w, r, param)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -568,6 +897,13 @@ func (siw *ServerInterfaceWrapper) GetLabelExplodeArray(w http.ResponseWriter, r
 func (siw *ServerInterfaceWrapper) GetLabelExplodeObject(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetLabelExplodeObject", "/labelExplodeObject/{.param*}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "param" -------------
 	var param Object
 
@@ -579,8 +915,10 @@ func (siw *ServerInterfaceWrapper) GetLabelExplodeObject(w http.ResponseWriter,
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetLabelExplodeObject(w, r, param)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -594,10 +932,20 @@ func (siw *ServerInterfaceWrapper) GetLabelExplodeObject(w http.ResponseWriter,
 func (siw *ServerInterfaceWrapper) GetLabelNoExplodeArray(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetLabelNoExplodeArray", "/labelNoExplodeArray/{.param}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "param" -------------
 	var param []int32
 
-	if err := runtime.BindStyledParameter("label", false, "param", chi.URLParam(r, "param"), &param); err != nil {
+	if raw, err := runtime.StyledParameterValues("label", false, "param", chi.URLParam(r, "param")); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "param"})
+		return
+	} else if param, err = runtime.ParseInt32Slice(raw); err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "param"})
 		return
 	}
@@ -605,8 +953,10 @@ func (siw *ServerInterfaceWrapper) GetLabelNoExplodeArray(w http.ResponseWriter,
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetLabelNoExplodeArray(w, r, param)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -620,6 +970,13 @@ func (siw *ServerInterfaceWrapper) GetLabelNoExplodeArray(w http.ResponseWriter,
 func (siw *ServerInterfaceWrapper) GetLabelNoExplodeObject(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetLabelNoExplodeObject", "/labelNoExplodeObject/{.param}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "param" -------------
 	var param Object
 
@@ -631,8 +988,10 @@ func (siw *ServerInterfaceWrapper) GetLabelNoExplodeObject(w http.ResponseWriter
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetLabelNoExplodeObject(w, r, param)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -646,10 +1005,20 @@ func (siw *ServerInterfaceWrapper) GetLabelNoExplodeObject(w http.ResponseWriter
 func (siw *ServerInterfaceWrapper) GetMatrixExplodeArray(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetMatrixExplodeArray", "/matrixExplodeArray/{.id*}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "id" -------------
 	var id []int32
 
-	if err := runtime.BindStyledParameter("matrix", true, "id", chi.URLParam(r, "id"), &id); err != nil {
+	if raw, err := runtime.StyledParameterValues("matrix", true, "id", chi.URLParam(r, "id")); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "id"})
+		return
+	} else if id, err = runtime.ParseInt32Slice(raw); err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "id"})
 		return
 	}
@@ -657,8 +1026,10 @@ func (siw *ServerInterfaceWrapper) GetMatrixExplodeArray(w http.ResponseWriter,
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetMatrixExplodeArray(w, r, id)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -672,6 +1043,13 @@ func (siw *ServerInterfaceWrapper) GetMatrixExplodeArray(w http.ResponseWriter,
 func (siw *ServerInterfaceWrapper) GetMatrixExplodeObject(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetMatrixExplodeObject", "/matrixExplodeObject/{.id*}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "id" -------------
 	var id Object
 
@@ -683,8 +1061,10 @@ func (siw *ServerInterfaceWrapper) GetMatrixExplodeObject(w http.ResponseWriter,
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetMatrixExplodeObject(w, r, id)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -698,10 +1078,20 @@ func (siw *ServerInterfaceWrapper) GetMatrixExplodeObject(w http.ResponseWriter,
 func (siw *ServerInterfaceWrapper) GetMatrixNoExplodeArray(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetMatrixNoExplodeArray", "/matrixNoExplodeArray/{.id}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "id" -------------
 	var id []int32
 
-	if err := runtime.BindStyledParameter("matrix", false, "id", chi.URLParam(r, "id"), &id); err != nil {
+	if raw, err := runtime.StyledParameterValues("matrix", false, "id", chi.URLParam(r, "id")); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "id"})
+		return
+	} else if id, err = runtime.ParseInt32Slice(raw); err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "id"})
 		return
 	}
@@ -709,8 +1099,10 @@ func (siw *ServerInterfaceWrapper) GetMatrixNoExplodeArray(w http.ResponseWriter
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetMatrixNoExplodeArray(w, r, id)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -724,6 +1116,13 @@ func (siw *ServerInterfaceWrapper) GetMatrixNoExplodeArray(w http.ResponseWriter
 func (siw *ServerInterfaceWrapper) GetMatrixNoExplodeObject(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetMatrixNoExplodeObject", "/matrixNoExplodeObject/{.id}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "id" -------------
 	var id Object
 
@@ -735,8 +1134,10 @@ func (siw *ServerInterfaceWrapper) GetMatrixNoExplodeObject(w http.ResponseWrite
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetMatrixNoExplodeObject(w, r, id)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -750,6 +1151,13 @@ func (siw *ServerInterfaceWrapper) GetMatrixNoExplodeObject(w http.ResponseWrite
 func (siw *ServerInterfaceWrapper) GetPassThrough(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetPassThrough", "/passThrough/{param}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "param" -------------
 	var param string
 
@@ -758,8 +1166,10 @@ func (siw *ServerInterfaceWrapper) GetPassThrough(w http.ResponseWriter, r *http
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetPassThrough(w, r, param)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -773,12 +1183,21 @@ func (siw *ServerInterfaceWrapper) GetPassThrough(w http.ResponseWriter, r *http
 func (siw *ServerInterfaceWrapper) GetDeepObject(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetDeepObject", "/queryDeepObject")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetDeepObjectParams
 
+	query := r.URL.Query()
+
 	// ------------- Required query parameter "deepObj" -------------
 
-	if err := runtime.BindQueryParameter("deepObject", true, true, "deepObj", r.URL.Query(), &params.DeepObj); err != nil {
+	if err := runtime.BindQueryParameter("deepObject", true, true, "deepObj", query, &params.DeepObj); err != nil {
 		err = fmt.Errorf("invalid format for parameter deepObj: %w", err)
 		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "deepObj"})
 		return
@@ -787,8 +1206,68 @@ func (siw *ServerInterfaceWrapper) GetDeepObject(w http.ResponseWriter, r *http.
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetDeepObject(w, r, params)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetQueryDelimited operation middleware
+func (siw *ServerInterfaceWrapper) GetQueryDelimited(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetQueryDelimited", "/queryDelimited")
+		defer call.End()
+		ctx = r.Context()
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetQueryDelimitedParams
+
+	// ------------- Optional query parameter "pa" -------------
+
+	if raw, found, err := runtime.RawQueryParameterValues("pipeDelimited", false, "pa", r.URL.RawQuery); err != nil {
+		err = fmt.Errorf("invalid format for parameter pa: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "pa"})
+		return
+	} else if found {
+		params.Pa = raw
+	}
+
+	// ------------- Optional query parameter "sa" -------------
+
+	if raw, found, err := runtime.RawQueryParameterValues("spaceDelimited", false, "sa", r.URL.RawQuery); err != nil {
+		err = fmt.Errorf("invalid format for parameter sa: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "sa"})
+		return
+	} else if found {
+		params.Sa = raw
+	}
+
+	// ------------- Optional query parameter "po" -------------
+
+	if err := runtime.BindRawQueryParameter("pipeDelimited", false, false, "po", r.URL.RawQuery, &params.Po); err != nil {
+		err = fmt.Errorf("invalid format for parameter po: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "po"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetQueryDelimited(w, r, params)
+		if resp != nil {
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -802,28 +1281,53 @@ func (siw *ServerInterfaceWrapper) GetDeepObject(w http.ResponseWriter, r *http.
 func (siw *ServerInterfaceWrapper) GetQueryForm(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetQueryForm", "/queryForm")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetQueryFormParams
 
+	query := r.URL.Query()
+
 	// ------------- Optional query parameter "ea" -------------
 
-	if err := runtime.BindQueryParameter("form", true, false, "ea", r.URL.Query(), &params.Ea); err != nil {
+	if raw, found, err := runtime.QueryParameterValues("form", true, "ea", query); err != nil {
 		err = fmt.Errorf("invalid format for parameter ea: %w", err)
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "ea"})
 		return
+	} else if found {
+		if value, err := runtime.ParseInt32Slice(raw); err != nil {
+			err = fmt.Errorf("invalid format for parameter ea: %w", err)
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "ea"})
+			return
+		} else {
+			params.Ea = value
+		}
 	}
 
 	// ------------- Optional query parameter "a" -------------
 
-	if err := runtime.BindQueryParameter("form", false, false, "a", r.URL.Query(), &params.A); err != nil {
+	if raw, found, err := runtime.QueryParameterValues("form", false, "a", query); err != nil {
 		err = fmt.Errorf("invalid format for parameter a: %w", err)
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "a"})
 		return
+	} else if found {
+		if value, err := runtime.ParseInt32Slice(raw); err != nil {
+			err = fmt.Errorf("invalid format for parameter a: %w", err)
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "a"})
+			return
+		} else {
+			params.A = value
+		}
 	}
 
 	// ------------- Optional query parameter "eo" -------------
 
-	if err := runtime.BindQueryParameter("form", true, false, "eo", r.URL.Query(), &params.Eo); err != nil {
+	if err := runtime.BindQueryParameter("form", true, false, "eo", query, &params.Eo); err != nil {
 		err = fmt.Errorf("invalid format for parameter eo: %w", err)
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "eo"})
 		return
@@ -831,7 +1335,7 @@ func (siw *ServerInterfaceWrapper) GetQueryForm(w http.ResponseWriter, r *http.R
 
 	// ------------- Optional query parameter "o" -------------
 
-	if err := runtime.BindQueryParameter("form", false, false, "o", r.URL.Query(), &params.O); err != nil {
+	if err := runtime.BindQueryParameter("form", false, false, "o", query, &params.O); err != nil {
 		err = fmt.Errorf("invalid format for parameter o: %w", err)
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "o"})
 		return
@@ -839,31 +1343,49 @@ func (siw *ServerInterfaceWrapper) GetQueryForm(w http.ResponseWriter, r *http.R
 
 	// ------------- Optional query parameter "ep" -------------
 
-	if err := runtime.BindQueryParameter("form", true, false, "ep", r.URL.Query(), &params.Ep); err != nil {
+	if raw, found, err := runtime.QueryParameterValue("form", true, "ep", query); err != nil {
 		err = fmt.Errorf("invalid format for parameter ep: %w", err)
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "ep"})
 		return
+	} else if found {
+		if value, err := runtime.ParseInt32(raw); err != nil {
+			err = fmt.Errorf("invalid format for parameter ep: %w", err)
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "ep"})
+			return
+		} else {
+			params.Ep = &value
+		}
 	}
 
 	// ------------- Optional query parameter "p" -------------
 
-	if err := runtime.BindQueryParameter("form", false, false, "p", r.URL.Query(), &params.P); err != nil {
+	if raw, found, err := runtime.QueryParameterValue("form", false, "p", query); err != nil {
 		err = fmt.Errorf("invalid format for parameter p: %w", err)
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "p"})
 		return
+	} else if found {
+		if value, err := runtime.ParseInt32(raw); err != nil {
+			err = fmt.Errorf("invalid format for parameter p: %w", err)
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "p"})
+			return
+		} else {
+			params.P = &value
+		}
 	}
 
 	// ------------- Optional query parameter "ps" -------------
 
-	if err := runtime.BindQueryParameter("form", true, false, "ps", r.URL.Query(), &params.Ps); err != nil {
+	if raw, found, err := runtime.QueryParameterValue("form", true, "ps", query); err != nil {
 		err = fmt.Errorf("invalid format for parameter ps: %w", err)
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "ps"})
 		return
+	} else if found {
+		params.Ps = &raw
 	}
 
 	// ------------- Optional query parameter "co" -------------
 
-	if paramValue := r.URL.Query().Get("co"); paramValue != "" {
+	if paramValue := query.Get("co"); paramValue != "" {
 
 		var value ComplexObject
 		if err := json.Unmarshal([]byte(paramValue), &value); err != nil {
@@ -876,17 +1398,21 @@ func (siw *ServerInterfaceWrapper) GetQueryForm(w http.ResponseWriter, r *http.R
 
 	// ------------- Optional query parameter "1s" -------------
 
-	if err := runtime.BindQueryParameter("form", true, false, "1s", r.URL.Query(), &params.N1s); err != nil {
+	if raw, found, err := runtime.QueryParameterValue("form", true, "1s", query); err != nil {
 		err = fmt.Errorf("invalid format for parameter 1s: %w", err)
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "1s"})
 		return
+	} else if found {
+		params.N1s = &raw
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetQueryForm(w, r, params)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -900,10 +1426,20 @@ func (siw *ServerInterfaceWrapper) GetQueryForm(w http.ResponseWriter, r *http.R
 func (siw *ServerInterfaceWrapper) GetSimpleExplodeArray(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetSimpleExplodeArray", "/simpleExplodeArray/{param*}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "param" -------------
 	var param []int32
 
-	if err := runtime.BindStyledParameter("simple", true, "param", chi.URLParam(r, "param"), &param); err != nil {
+	if raw, err := runtime.StyledParameterValues("simple", true, "param", chi.URLParam(r, "param")); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "param"})
+		return
+	} else if param, err = runtime.ParseInt32Slice(raw); err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "param"})
 		return
 	}
@@ -911,8 +1447,10 @@ func (siw *ServerInterfaceWrapper) GetSimpleExplodeArray(w http.ResponseWriter,
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetSimpleExplodeArray(w, r, param)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -926,6 +1464,13 @@ func (siw *ServerInterfaceWrapper) GetSimpleExplodeArray(w http.ResponseWriter,
 func (siw *ServerInterfaceWrapper) GetSimpleExplodeObject(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetSimpleExplodeObject", "/simpleExplodeObject/{param*}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "param" -------------
 	var param Object
 
@@ -937,8 +1482,10 @@ func (siw *ServerInterfaceWrapper) GetSimpleExplodeObject(w http.ResponseWriter,
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetSimpleExplodeObject(w, r, param)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -952,10 +1499,20 @@ func (siw *ServerInterfaceWrapper) GetSimpleExplodeObject(w http.ResponseWriter,
 func (siw *ServerInterfaceWrapper) GetSimpleNoExplodeArray(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetSimpleNoExplodeArray", "/simpleNoExplodeArray/{param}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "param" -------------
 	var param []int32
 
-	if err := runtime.BindStyledParameter("simple", false, "param", chi.URLParam(r, "param"), &param); err != nil {
+	if raw, err := runtime.StyledParameterValues("simple", false, "param", chi.URLParam(r, "param")); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "param"})
+		return
+	} else if param, err = runtime.ParseInt32Slice(raw); err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "param"})
 		return
 	}
@@ -963,8 +1520,10 @@ func (siw *ServerInterfaceWrapper) GetSimpleNoExplodeArray(w http.ResponseWriter
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetSimpleNoExplodeArray(w, r, param)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -978,6 +1537,13 @@ func (siw *ServerInterfaceWrapper) GetSimpleNoExplodeArray(w http.ResponseWriter
 func (siw *ServerInterfaceWrapper) GetSimpleNoExplodeObject(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetSimpleNoExplodeObject", "/simpleNoExplodeObject/{param}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "param" -------------
 	var param Object
 
@@ -989,8 +1555,10 @@ func (siw *ServerInterfaceWrapper) GetSimpleNoExplodeObject(w http.ResponseWrite
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetSimpleNoExplodeObject(w, r, param)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -1004,10 +1572,20 @@ func (siw *ServerInterfaceWrapper) GetSimpleNoExplodeObject(w http.ResponseWrite
 func (siw *ServerInterfaceWrapper) GetSimplePrimitive(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetSimplePrimitive", "/simplePrimitive/{param}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "param" -------------
 	var param int32
 
-	if err := runtime.BindStyledParameter("simple", false, "param", chi.URLParam(r, "param"), &param); err != nil {
+	if raw, err := runtime.StyledParameterValue("simple", false, "param", chi.URLParam(r, "param")); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "param"})
+		return
+	} else if param, err = runtime.ParseInt32(raw); err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "param"})
 		return
 	}
@@ -1015,8 +1593,10 @@ func (siw *ServerInterfaceWrapper) GetSimplePrimitive(w http.ResponseWriter, r *
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetSimplePrimitive(w, r, param)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -1030,6 +1610,13 @@ func (siw *ServerInterfaceWrapper) GetSimplePrimitive(w http.ResponseWriter, r *
 func (siw *ServerInterfaceWrapper) GetStartingWithNumber(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetStartingWithNumber", "/startingWithNumber/{1param}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "1param" -------------
 	var n1param string
 
@@ -1038,8 +1625,10 @@ func (siw *ServerInterfaceWrapper) GetStartingWithNumber(w http.ResponseWriter,
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetStartingWithNumber(w, r, n1param)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -1139,17 +1728,33 @@ type ServerOptions struct {
 	BaseURL          string
 	BaseRouter       chi.Router
 	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	Observer         runtime.Observer
+	SpecRoute        string
+	SpecUIRoute      string
+	SpecUI           func(specURL string) http.Handler
 }
 
 type ServerOption func(*ServerOptions)
 
 // Handler creates http.Handler with routing matching OpenAPI spec.
+// It panics if the options are invalid, such as when a tagged middleware is missing.
 func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
+	h, err := NewHandler(si, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// NewHandler creates http.Handler with routing matching OpenAPI spec.
+// An error is returned if the options are invalid, such as when a tagged middleware is missing.
+func NewHandler(si ServerInterface, opts ...ServerOption) (http.Handler, error) {
 	options := &ServerOptions{
-		BaseURL:    "/",
+		BaseURL:    defaultBaseURL,
 		BaseRouter: chi.NewRouter(),
 		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			status := http.StatusBadRequest
+			http.Error(w, err.Error(), status)
 		},
 	}
 
@@ -1161,6 +1766,18 @@ func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
 	wrapper := ServerInterfaceWrapper{
 		Handler:          si,
 		ErrorHandlerFunc: options.ErrorHandlerFunc,
+		Observer:         options.Observer,
+	}
+
+	// Errors, such as parameter binding errors, are recorded on the observed
+	// call of the operation.
+	wrapper.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+		runtime.SetCallError(r.Context(), err)
+		options.ErrorHandlerFunc(w, r, err)
+	}
+
+	if options.SpecUI != nil && options.SpecRoute == "" {
+		return nil, errors.New("goapi-gen: WithSpecUI requires WithSpecRoute")
 	}
 
 	r.Route(options.BaseURL, func(r chi.Router) {
@@ -1177,6 +1794,7 @@ func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
 		r.Get("/matrixNoExplodeObject/{id}", wrapper.GetMatrixNoExplodeObject)
 		r.Get("/passThrough/{param}", wrapper.GetPassThrough)
 		r.Get("/queryDeepObject", wrapper.GetDeepObject)
+		r.Get("/queryDelimited", wrapper.GetQueryDelimited)
 		r.Get("/queryForm", wrapper.GetQueryForm)
 		r.Get("/simpleExplodeArray/{param}", wrapper.GetSimpleExplodeArray)
 		r.Get("/simpleExplodeObject/{param}", wrapper.GetSimpleExplodeObject)
@@ -1184,8 +1802,17 @@ func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
 		r.Get("/simpleNoExplodeObject/{param}", wrapper.GetSimpleNoExplodeObject)
 		r.Get("/simplePrimitive/{param}", wrapper.GetSimplePrimitive)
 		r.Get("/startingWithNumber/{1param}", wrapper.GetStartingWithNumber)
+
+		if options.SpecRoute != "" {
+			r.Method(http.MethodGet, options.SpecRoute, SpecHandler(opts...))
+		}
+		if options.SpecUI != nil {
+			ui := options.SpecUI(path.Join(options.BaseURL, options.SpecRoute))
+			r.Method(http.MethodGet, options.SpecUIRoute, ui)
+			r.Method(http.MethodGet, strings.TrimSuffix(options.SpecUIRoute, "/")+"/*", ui)
+		}
 	})
-	return r
+	return r, nil
 }
 
 func WithRouter(r chi.Router) ServerOption {
@@ -1206,28 +1833,116 @@ func WithErrorHandler(handler func(w http.ResponseWriter, r *http.Request, err e
 	}
 }
 
+// WithObserver reports each call of an operation to o once it returns, with
+// its operation ID, method, route template, status code, response size,
+// duration and the error passed to the error handler, if any.
+func WithObserver(o runtime.Observer) ServerOption {
+	return func(s *ServerOptions) {
+		s.Observer = o
+	}
+}
+
+// WithSpecRoute serves the embedded OpenAPI specification at pattern, relative
+// to the base URL. See SpecHandler.
+func WithSpecRoute(pattern string) ServerOption {
+	return func(s *ServerOptions) {
+		s.SpecRoute = pattern
+	}
+}
+
+// WithSpecUI serves an API explorer for the specification served by
+// WithSpecRoute at pattern, relative to the base URL. ui creates the
+// explorer's handler from the URL of the specification, for example
+// specui.SwaggerUI.
+func WithSpecUI(pattern string, ui func(specURL string) http.Handler) ServerOption {
+	return func(s *ServerOptions) {
+		s.SpecUIRoute = pattern
+		s.SpecUI = ui
+	}
+}
+
+// SpecHandler returns an http.Handler serving the embedded OpenAPI
+// specification as JSON, or as YAML if the Accept header prefers it. The
+// servers of the specification are replaced by the request's host and the
+// base URL of opts.
+func SpecHandler(opts ...ServerOption) http.Handler {
+	options := &ServerOptions{
+		BaseURL: defaultBaseURL,
+	}
+	for _, f := range opts {
+		f(options)
+	}
+
+	swagger, loadErr := GetSwagger()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if loadErr != nil {
+			http.Error(w, loadErr.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		scheme := "http"
+		if r.TLS != nil {
+			scheme = "https"
+		}
+		if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+			scheme = proto
+		}
+
+		spec := *swagger
+		spec.Servers = openapi3.Servers{
+			{URL: scheme + "://" + r.Host + strings.TrimSuffix(options.BaseURL, "/")},
+		}
+		data, err := json.Marshal(&spec)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		contentType := runtime.NegotiateContentType(r.Header.Get("Accept"),
+			"application/json", "application/yaml", "application/x-yaml", "text/yaml")
+		switch contentType {
+		case "application/yaml", "application/x-yaml", "text/yaml":
+			var v interface{}
+			if err := yaml.Unmarshal(data, &v); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			if data, err = yaml.Marshal(v); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		default:
+			contentType = "application/json"
+		}
+
+		w.Header().Set("Content-Type", contentType)
+		w.Write(data)
+	})
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xa34+jNhD+V6JpnyoSkrs33lbXXyv19q7NSlfptA9emARfAftsZ5tVxP9e2UAAQwgk",
-	"YTfbtwvMzDfzefydPewOfBZzlmCiJHg7ECg5SySaH0sa8wj/yh/pJz5LFCZK/1PhVrk8IjTRv6QfYkzM",
-	"82eO4IFUgiZrSNPUgQClLyhXlCXgwc1EmriTAmvCHr+hr0CbZnEM+gemrbafspfeDrhgHIWiWXK3QQWN",
-	"JgrXKCB14FbeBHGWVP7ykbEISaJflsF+FLgCD35wy/rdHNz9VOYj8PuGCgzA+1o4Oxq6xHmoha3nuKJC",
-	"qjsSYwsxDggWtb2wUI2VUwn1YDilyYpp54j6mC9OYoDg4+29jq6o0uHhHqWaLFE8oQAHnlDIbBkWs/ls",
-	"rg0Zx4RwCh68n81nC3CAExWa/N18vbP63B0ngsSpfrNGU64uluh11asBv6H6UHUwoQSJUaGQ4H2t9Q/h",
-	"PKK+cXa/SWZ1Udfy1BsjZwM8kzY4BQ0GGapcKrHB9MGp9/i7+fwQ3t7OtTZCajBdn7F/KHazYSwaNNQ3",
-	"BBc0poo+aUPc8ogFCN6KRBLzwvwiTFEaOBWqVkzERGWb4P07cBp7InV6IWp6DgDi2Yg5SjAhQpDnvrCk",
-	"BksVxrIX/v5JhtaSTyONLr7HS2NPCys2TC9eWC2hflJmQzcRuyg4DXGs7V6vxM8MSg5bK/AZNEnQ7yZS",
-	"EaFosp78S1U4STbxo5HK1igLWSPClu66uiSbKDJKESIJUHQpxe+ZxblKERZh8nT/nn6uuIyqGR3Q01/y",
-	"Nn8RFWkmcqOt25N4MU05kNUrK0szq2ybtZM1htAcyuDN6U2zkDxQUdAJ6mPHXEyXufX0C1Xh9K6wHqxI",
-	"EXnEKF9k04jubmak56fO490ftltTsdrarM/J7DIbwQGpns2511QIlzzvVTkrTsRDSTt0ML4Ea312yej8",
-	"3LG2rjrOT92vg6CqePyP+mpff72zBhB3tLXOYe61eysmStCt1Vo06N54HxtOp2w8GozeU1l14xG276lB",
-	"jJ2uVUcoG9ZMo5HTkCoa9CDnAkL1ljuqqVPDWDtDpa69qziR8j4UbLMO+4zKPpfmnYOyAYPWVxmDfd+g",
-	"eP4ZkZdT0EMlV6yO3HQDRN59dTGwZZ1BFvrkDrFO/WWjBGXOhw7TJpVfmYi7av9zb3Sk9F6XXKv6i03K",
-	"yrq1Kwy85FpZvVhS/S67NmfjT9EsxEsA7ks9No+xqx1naNxR7eUAJ7nGHcDpHsm98ljASva0KaQVZNAQ",
-	"8ixtz77U1Y9JPW68y4bb9c4JshJhNNZq384G0HY9k4LRGLIP4MfPTMsWvyueFYzPXP8vs8s2x6uYFozG",
-	"0v4DRH9+qp9LLGZOYqJH84xJQ/5/yheqwmxW7O4WPahouI14QVmMfEPRDJu/fsjy3ogIPAiV4p7r4pZo",
-	"+5nPYkgf0v8CAAD//0duf5cNIwAA",
+	"H4sIAAAAAAAC/9xaTW/bOBP+K8K872mhWHZ70y3ofgXYpt11gF2gyIGRxja7ksiSdNaBof++ICVZEvVh",
+	"ybYSd2+1ODPPzMOZKTnMHgIWc5ZgoiT4exAoOUskmh9LGvMI/8g/6S8BSxQmSv9T4U55PCI00b9ksMGY",
+	"mO8vHMEHqQRN1pCmqQshykBQrihLwIdbRxq7ToHlsKevGCjQopkdg/6Baandp2zR3wMXjKNQNHPuLqyg",
+	"0UThGgWkLtzJ2zCmSWXxibEISaIXS2P/F7gCH/7nlfF7Obj3qfRH4LctFRiC/6VQdjV0ifNYM1v3cUWF",
+	"VPckxhZiXBAsaluwUI2UWzH1aDilyYpp5YgGmG9OYoDg492Dtq6o0ubhAaVyliieUYALzyhktg2L2Xw2",
+	"14KMY0I4BR/ez+azBbjAidoY/718v7P4vD0ngsSpXlmjCVcHS/S+6t2AX1B9qCoYU4LEqFBI8L/U8odw",
+	"HtHAKHtfJbOyqG976omRswG+cRvcggaDDFUuldhi+ujWc/zdfN6Fd5DzrEJIDaYXMPY3xX42jESDhnpB",
+	"cEFjquizFsQdj1iI4K9IJDEPLCjMFKGBW6FqxURMVFYE79+B26iJ1B2EqOnpAMSzEXOU0CFCkJehsKQG",
+	"SxXGchD+4UuG1uJPw40+vqdz40ALKwpmEC+s5tCwVmZDNxH7KDgNcapyr0cSZAIlh60RBAyaJOg1Ryoi",
+	"FE3Wzj9UbZxkGz+h6LKykDUi7NZd7y7JNopMp9ggCVH0dYpfM4lzO8WmMJO7+9fN54rKpD2jB/rmpzzN",
+	"X6WLNB251dLtTrxaT+nw6o07S9OrrMzayZqi0XR58N31m2YguaEioBO6j21zcbPMpW/+pGpzc19Ij+5I",
+	"EXnCKN9kk4jefmZazw+9x7vfbLVmx2pLsyEns8sUggtSvZhzr4kQLnneq3JWnIjHktZ1ML4Ea0OqZHJ+",
+	"7llbVh3np67XQ1C1efyH8uoQfz2zRhB3NLXOYe6tcysmStCdlVo07C+8jw2lUwqPhpPnVBbddIQdcmoU",
+	"Y6f3qiOUjUumychptCoaDiDnAo3qe86oZp8ax9oZXeras4oTKR82gm3XmyGjss+leO+gbMSg9U3GYN+2",
+	"KF5+ROTlFLQr5IrUkZtuiMj7ry4GtowzzEyfnCHWqb9MlLD0ueswnTMQ6esuhn0E/F6XPHbdpxydsJB2",
+	"eu+XFh28Y2bVGEJ3NRCNXTravLtIToJTnZPnOmfA+7yzmOu/gtrUjZ91ddJ26TL7mYn4aH4ZoSOpNWiS",
+	"YhFzsXFsSZdWhZGTFMurV3Nq2ETF5mz6Ua2FeAnAQ6jHhn52tNO8TPQV68UAnbztdOD0z33fePZkOXva",
+	"qNsyMmrSfVZny56D62fxAWOVZUPteodRWYgwGWu1B9oRtF3POGoyhuxb3vGD+bJF74oHUtMzN/z5f9mm",
+	"eBUjqclYOrxyDeen+iZnMXMSEwOSZ0oa8v9T9ItE9iDh7RcDqGioTXgLXkx8DdYMmz+xyfzeigh82CjF",
+	"fc/DHdHys4DFkD6m/w4AUAW4IXIlAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
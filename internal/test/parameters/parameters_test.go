@@ -4,12 +4,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/discord-gophers/goapi-gen/internal/testutil"
+	"github.com/discord-gophers/goapi-gen/runtime"
 )
 
 type testServer struct {
@@ -22,6 +24,7 @@ type testServer struct {
 	primitiveString *string
 	cookieParams    *GetCookieParams
 	queryParams     *GetQueryFormParams
+	delimitedParams *GetQueryDelimitedParams
 	headerParams    *GetHeaderParams
 }
 
@@ -35,6 +38,7 @@ func (t *testServer) reset() *Response {
 	t.primitiveString = nil
 	t.cookieParams = nil
 	t.queryParams = nil
+	t.delimitedParams = nil
 	t.headerParams = nil
 	return nil
 }
@@ -174,6 +178,12 @@ func (t *testServer) GetQueryForm(w http.ResponseWriter, r *http.Request, params
 	return nil
 }
 
+// (GET /queryDelimited)
+func (t *testServer) GetQueryDelimited(w http.ResponseWriter, r *http.Request, params GetQueryDelimitedParams) *Response {
+	t.delimitedParams = &params
+	return nil
+}
+
 //  (GET /header)
 func (t *testServer) GetHeader(w http.ResponseWriter, r *http.Request, params GetHeaderParams) *Response {
 	t.headerParams = &params
@@ -427,6 +437,23 @@ func TestParameterBinding(t *testing.T) {
 	assert.EqualValues(t, &expectedComplexObject, ts.complexObject)
 	ts.reset()
 
+	// delimited values holding escaped delimiters
+	delimited := []string{"a|b", "c d", "e,f", "g&h"}
+	pa, err := runtime.StyleParamWithLocation("pipeDelimited", false, "pa", runtime.ParamLocationQuery, delimited)
+	require.NoError(t, err)
+	sa, err := runtime.StyleParamWithLocation("spaceDelimited", false, "sa", runtime.ParamLocationQuery, delimited)
+	require.NoError(t, err)
+	po, err := runtime.StyleParamWithLocation("pipeDelimited", false, "po", runtime.ParamLocationQuery,
+		Object{FirstName: "A|lex", Role: "ad min"})
+	require.NoError(t, err)
+	result = testutil.NewRequest().Get("/queryDelimited?" + pa + "&" + sa + "&" + po).GoWithHTTPHandler(t, handler)
+	assert.Equal(t, http.StatusOK, result.Code())
+	require.NotNil(t, ts.delimitedParams)
+	assert.Equal(t, delimited, ts.delimitedParams.Pa)
+	assert.Equal(t, delimited, ts.delimitedParams.Sa)
+	assert.Equal(t, &Object{FirstName: "A|lex", Role: "ad min"}, ts.delimitedParams.Po)
+	ts.reset()
+
 	// ---------------------- Test Header Query Parameters --------------------
 
 	// unexploded header primitive.
@@ -467,6 +494,34 @@ func TestParameterBinding(t *testing.T) {
 	assert.EqualValues(t, &expectedObject, ts.object)
 	ts.reset()
 
+	// repeated and comma-separated header lines
+	req := httptest.NewRequest(http.MethodGet, "/header", nil)
+	req.Header.Add("X-Array", "3, 4")
+	req.Header.Add("X-Array", "5")
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.EqualValues(t, expectedArray, ts.array)
+	ts.reset()
+
+	req = httptest.NewRequest(http.MethodGet, "/header", nil)
+	req.Header.Add("X-Object-Exploded", "role=admin")
+	req.Header.Add("X-Object-Exploded", "firstName=Alex")
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.EqualValues(t, &expectedObject, ts.object)
+	ts.reset()
+
+	// repeated primitive header lines
+	req = httptest.NewRequest(http.MethodGet, "/header", nil)
+	req.Header.Add("X-Primitive", "5")
+	req.Header.Add("X-Primitive", "6")
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Nil(t, ts.primitive)
+
 	// complex object
 	result = testutil.NewRequest().WithHeader("X-Complex-Object",
 		string(marshaledComplexObject)).Get("/header").GoWithHTTPHandler(t, handler)
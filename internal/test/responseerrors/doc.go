@@ -0,0 +1,3 @@
+package responseerrors
+
+//go:generate go run github.com/discord-gophers/goapi-gen --generate=types,server,errors --package=responseerrors -o responseerrors.gen.go responseerrors.yaml
@@ -0,0 +1,544 @@
+// Package responseerrors provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/discord-gophers/goapi-gen version (devel) DO NOT EDIT.
+package responseerrors
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/discord-gophers/goapi-gen/runtime"
+	"github.com/go-chi/chi/v5"
+)
+
+// Error defines model for Error.
+type Error struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+// NotFound defines model for NotFound.
+type NotFound struct {
+	ID int `json:"id"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// Response is a common response struct for all the API calls.
+// A Response object may be instantiated via functions for specific operation responses.
+// It may also be instantiated directly, for the purpose of responding with a single status code.
+type Response struct {
+	body        interface{}
+	Code        int
+	contentType string
+	offers      []string
+	raw         bool
+}
+
+// Render writes the response. It sets the Content-Type header and status code
+// based on the response definition, and writes the body encoded for its content type.
+// Raw bodies, such as text/plain or image/png, are written as is.
+// Nothing is written if the body can't be encoded.
+//
+// Responses with several representations are written in the one which best
+// matches the Accept header of the request, or in the default content type
+// when none is acceptable.
+func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
+	contentType := resp.contentType
+	if accept := r.Header.Get("Accept"); accept != "" && len(resp.offers) > 0 {
+		offers := append([]string{contentType}, resp.offers...)
+		if ct := runtime.NegotiateContentType(accept, offers...); ct != "" {
+			contentType = ct
+		}
+	}
+
+	if resp.raw {
+		w.Header().Set("Content-Type", contentType)
+		w.WriteHeader(resp.Code)
+		switch body := resp.body.(type) {
+		case string:
+			io.WriteString(w, body)
+		case []byte:
+			w.Write(body)
+		case io.Reader:
+			if c, ok := body.(io.Closer); ok {
+				defer c.Close()
+			}
+			io.Copy(w, body)
+		}
+		return nil
+	}
+
+	var data []byte
+	var err error
+	switch {
+	case strings.Contains(contentType, "xml"):
+		data, err = xml.Marshal(resp)
+		data = append([]byte(xml.Header), data...)
+	case strings.Contains(contentType, "yaml"):
+		data, err = runtime.MarshalYAML(resp.body)
+	default:
+		data, err = json.Marshal(resp.body)
+	}
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(resp.Code)
+	w.Write(data)
+	return nil
+}
+
+// Status is a builder method to override the default status code for a response.
+func (resp *Response) Status(code int) *Response {
+	resp.Code = code
+	return resp
+}
+
+// ContentType is a builder method to override the default content type for a response.
+// The default content type of a response with several representations is
+// used when the Accept header of the request allows none of them.
+func (resp *Response) ContentType(contentType string) *Response {
+	resp.contentType = contentType
+	return resp
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// This is used to only marshal the body of the response.
+func (resp *Response) MarshalJSON() ([]byte, error) {
+	return json.Marshal(resp.body)
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+// This is used to only marshal the body of the response.
+func (resp *Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.Encode(resp.body)
+}
+
+// DeletePetJSON5xXResponse is a constructor method for a DeletePet response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeletePetJSON5xXResponse(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetPetJSON200Response is a constructor method for a GetPet response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetPetJSON200Response(body Pet) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetPetJSON404Response is a constructor method for a GetPet response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetPetJSON404Response(body NotFound) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetPetJSONDefaultResponse is a constructor method for a GetPet response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetPetJSONDefaultResponse(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// defaultBaseURL is the base URL of the server, the path of the URL of the
+// first server of the specification.
+const defaultBaseURL = "/"
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (DELETE /pets/{id})
+	DeletePet(w http.ResponseWriter, r *http.Request, id int) (*Response, error)
+
+	// (GET /pets/{id})
+	GetPet(w http.ResponseWriter, r *http.Request, id int) (*Response, error)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler              ServerInterface
+	ErrorHandlerFunc     func(w http.ResponseWriter, r *http.Request, err error)
+	Observer             runtime.Observer
+	ResponseErrorHandler ResponseErrorHandler
+}
+
+// DeletePet operation middleware
+func (siw *ServerInterfaceWrapper) DeletePet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "DeletePet", "/pets/{id}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	if raw, err := runtime.StyledParameterValue("simple", false, "id", chi.URLParam(r, "id")); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "id"})
+		return
+	} else if id, err = runtime.ParseInt(raw); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "id"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp, err := siw.Handler.DeletePet(w, r, id)
+		if err != nil {
+			runtime.SetCallError(r.Context(), err)
+			resp = siw.ResponseErrorHandler.Response(err, DeletePetErrorResponse)
+		}
+		if resp != nil {
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetPet operation middleware
+func (siw *ServerInterfaceWrapper) GetPet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetPet", "/pets/{id}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	if raw, err := runtime.StyledParameterValue("simple", false, "id", chi.URLParam(r, "id")); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "id"})
+		return
+	} else if id, err = runtime.ParseInt(raw); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "id"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp, err := siw.Handler.GetPet(w, r, id)
+		if err != nil {
+			runtime.SetCallError(r.Context(), err)
+			resp = siw.ResponseErrorHandler.Response(err, GetPetErrorResponse)
+		}
+		if resp != nil {
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	err       error
+	paramName string
+}
+
+// Error implements error.
+func (err UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter %s: %v", err.paramName, err.err)
+}
+
+func (err UnescapedCookieParamError) Unwrap() error { return err.err }
+
+type UnmarshalingParamError struct {
+	err       error
+	paramName string
+}
+
+// Error implements error.
+func (err UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("error unmarshaling parameter %s as JSON: %v", err.paramName, err.err)
+}
+
+func (err UnmarshalingParamError) Unwrap() error { return err.err }
+
+type RequiredParamError struct {
+	err       error
+	paramName string
+}
+
+// Error implements error.
+func (err RequiredParamError) Error() string {
+	if err.err == nil {
+		return fmt.Sprintf("query parameter %s is required, but not found", err.paramName)
+	} else {
+		return fmt.Sprintf("query parameter %s is required, but errored: %s", err.paramName, err.err)
+	}
+}
+
+func (err RequiredParamError) Unwrap() error { return err.err }
+
+type RequiredHeaderError struct {
+	paramName string
+}
+
+// Error implements error.
+func (err RequiredHeaderError) Error() string {
+	return fmt.Sprintf("header parameter %s is required, but not found", err.paramName)
+}
+
+type InvalidParamFormatError struct {
+	err       error
+	paramName string
+}
+
+// Error implements error.
+func (err InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("invalid format for parameter %s: %v", err.paramName, err.err)
+}
+
+func (err InvalidParamFormatError) Unwrap() error { return err.err }
+
+type TooManyValuesForParamError struct {
+	NumValues int
+	paramName string
+}
+
+// Error implements error.
+func (err TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("expected one value for %s, got %d", err.paramName, err.NumValues)
+}
+
+// ParameterName is an interface that is implemented by error types that are
+// relevant to a specific parameter.
+type ParameterError interface {
+	error
+	// ParamName is the name of the parameter that the error is referring to.
+	ParamName() string
+}
+
+func (err UnescapedCookieParamError) ParamName() string  { return err.paramName }
+func (err UnmarshalingParamError) ParamName() string     { return err.paramName }
+func (err RequiredParamError) ParamName() string         { return err.paramName }
+func (err RequiredHeaderError) ParamName() string        { return err.paramName }
+func (err InvalidParamFormatError) ParamName() string    { return err.paramName }
+func (err TooManyValuesForParamError) ParamName() string { return err.paramName }
+
+type ServerOptions struct {
+	BaseURL              string
+	BaseRouter           chi.Router
+	ErrorHandlerFunc     func(w http.ResponseWriter, r *http.Request, err error)
+	Observer             runtime.Observer
+	ResponseErrorHandler ResponseErrorHandler
+}
+
+type ServerOption func(*ServerOptions)
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+// It panics if the options are invalid, such as when a tagged middleware is missing.
+func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
+	h, err := NewHandler(si, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// NewHandler creates http.Handler with routing matching OpenAPI spec.
+// An error is returned if the options are invalid, such as when a tagged middleware is missing.
+func NewHandler(si ServerInterface, opts ...ServerOption) (http.Handler, error) {
+	options := &ServerOptions{
+		BaseURL:    defaultBaseURL,
+		BaseRouter: chi.NewRouter(),
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			status := http.StatusBadRequest
+			http.Error(w, err.Error(), status)
+		},
+	}
+
+	for _, f := range opts {
+		f(options)
+	}
+
+	r := options.BaseRouter
+	wrapper := ServerInterfaceWrapper{
+		Handler:              si,
+		ErrorHandlerFunc:     options.ErrorHandlerFunc,
+		Observer:             options.Observer,
+		ResponseErrorHandler: options.ResponseErrorHandler,
+	}
+
+	// Errors, such as parameter binding errors, are recorded on the observed
+	// call of the operation.
+	wrapper.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+		runtime.SetCallError(r.Context(), err)
+		options.ErrorHandlerFunc(w, r, err)
+	}
+
+	r.Route(options.BaseURL, func(r chi.Router) {
+		r.Delete("/pets/{id}", wrapper.DeletePet)
+		r.Get("/pets/{id}", wrapper.GetPet)
+	})
+	return r, nil
+}
+
+func WithRouter(r chi.Router) ServerOption {
+	return func(s *ServerOptions) {
+		s.BaseRouter = r
+	}
+}
+
+func WithServerBaseURL(url string) ServerOption {
+	return func(s *ServerOptions) {
+		s.BaseURL = url
+	}
+}
+
+func WithErrorHandler(handler func(w http.ResponseWriter, r *http.Request, err error)) ServerOption {
+	return func(s *ServerOptions) {
+		s.ErrorHandlerFunc = handler
+	}
+}
+
+// WithResponseErrorHandler maps the errors returned by handlers to responses
+// with h.
+func WithResponseErrorHandler(h ResponseErrorHandler) ServerOption {
+	return func(s *ServerOptions) {
+		s.ResponseErrorHandler = h
+	}
+}
+
+// WithObserver reports each call of an operation to o once it returns, with
+// its operation ID, method, route template, status code, response size,
+// duration and the error passed to the error handler, if any.
+func WithObserver(o runtime.Observer) ServerOption {
+	return func(s *ServerOptions) {
+		s.Observer = o
+	}
+}
+
+// HTTPError is an error responding with the status code Code. Body, if set,
+// is the body of the response declared by the operation for Code, otherwise
+// the body is built by the ResponseErrorHandler.
+type HTTPError struct {
+	Code int
+	Body interface{}
+	Err  error
+}
+
+// Error implements error.
+func (err *HTTPError) Error() string {
+	if err.Err == nil {
+		return fmt.Sprintf("%d %s", err.Code, http.StatusText(err.Code))
+	}
+	return err.Err.Error()
+}
+
+func (err *HTTPError) Unwrap() error { return err.Err }
+
+// ResponseErrorHandler maps the errors returned by handlers to the error
+// responses declared by their operation, so that they are written with a body
+// of the declared type.
+//
+// The status code of an error is the Code of the HTTPError it wraps, or of the
+// HTTPError returned by the first of the Hooks matching it. It is 500 for any
+// other error.
+type ResponseErrorHandler struct {
+	// Hooks map errors which don't wrap an HTTPError, typically by matching
+	// their type with errors.As. They return nil for errors they don't match.
+	Hooks []func(err error) *HTTPError
+
+	// Body builds the body of the response for an error whose HTTPError has
+	// none. It must return a value of the type the operation declares for the
+	// status code, otherwise the response is written without a body.
+	Body func(code int, err error) interface{}
+}
+
+// Response returns the response for err, built with respond which returns
+// the response an operation declares for a status code and body, such as
+// FindPetsErrorResponse.
+func (h ResponseErrorHandler) Response(err error, respond func(code int, body interface{}) *Response) *Response {
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) {
+		for _, hook := range h.Hooks {
+			if httpErr = hook(err); httpErr != nil {
+				break
+			}
+		}
+	}
+	if httpErr == nil || httpErr.Code == 0 {
+		httpErr = &HTTPError{Code: http.StatusInternalServerError, Err: err}
+	}
+
+	body := httpErr.Body
+	if body == nil && h.Body != nil {
+		body = h.Body(httpErr.Code, err)
+	}
+	if resp := respond(httpErr.Code, body); resp != nil {
+		return resp
+	}
+	return &Response{Code: httpErr.Code}
+}
+
+// DeletePetErrorResponse returns the response DeletePet declares for the status code
+// code with body, or nil if none is declared or body isn't of the type declared for code.
+func DeletePetErrorResponse(code int, body interface{}) *Response {
+	switch {
+	case code == 409:
+		return &Response{Code: code}
+	case code/100 == 5:
+		if body, ok := body.(Error); ok {
+			return DeletePetJSON5xXResponse(body).Status(code)
+		}
+	}
+	return nil
+}
+
+// GetPetErrorResponse returns the response GetPet declares for the status code
+// code with body, or nil if none is declared or body isn't of the type declared for code.
+func GetPetErrorResponse(code int, body interface{}) *Response {
+	switch {
+	case code == 404:
+		if body, ok := body.(NotFound); ok {
+			return GetPetJSON404Response(body).Status(code)
+		}
+	default:
+		if body, ok := body.(Error); ok {
+			return GetPetJSONDefaultResponse(body).Status(code)
+		}
+	}
+	return nil
+}
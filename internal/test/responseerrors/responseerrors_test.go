@@ -0,0 +1,105 @@
+package responseerrors
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errNotFound = errors.New("not found")
+
+type conflictError struct{ id int }
+
+func (err conflictError) Error() string { return fmt.Sprintf("pet %d has pending orders", err.id) }
+
+type server struct{}
+
+func (server) GetPet(w http.ResponseWriter, r *http.Request, id int) (*Response, error) {
+	switch id {
+	case 1:
+		return GetPetJSON200Response(Pet{ID: id, Name: "Rex"}), nil
+	case 2:
+		return nil, &HTTPError{Code: http.StatusNotFound, Body: NotFound{ID: id}}
+	case 3:
+		return nil, fmt.Errorf("pet %d: %w", id, errNotFound)
+	}
+	return nil, errors.New("database unavailable")
+}
+
+func (server) DeletePet(w http.ResponseWriter, r *http.Request, id int) (*Response, error) {
+	if id == 1 {
+		return nil, conflictError{id}
+	}
+	return nil, &HTTPError{Code: http.StatusServiceUnavailable, Err: errors.New("maintenance")}
+}
+
+func TestResponseErrorHandler(t *testing.T) {
+	h := Handler(server{}, WithResponseErrorHandler(ResponseErrorHandler{
+		Hooks: []func(err error) *HTTPError{
+			func(err error) *HTTPError {
+				if errors.Is(err, errNotFound) {
+					return &HTTPError{Code: http.StatusNotFound, Err: err}
+				}
+				return nil
+			},
+			func(err error) *HTTPError {
+				var conflict conflictError
+				if errors.As(err, &conflict) {
+					return &HTTPError{Code: http.StatusConflict, Err: err}
+				}
+				return nil
+			},
+		},
+		Body: func(code int, err error) interface{} {
+			return Error{Code: int32(code), Message: err.Error()}
+		},
+	}))
+
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		code   int
+		body   string
+	}{
+		{name: "ok", method: http.MethodGet, url: "/pets/1", code: http.StatusOK, body: `{"id":1,"name":"Rex"}`},
+		{name: "http error body", method: http.MethodGet, url: "/pets/2", code: http.StatusNotFound, body: `{"id":2}`},
+		// The 404 response declares a NotFound body, which Body doesn't build.
+		{name: "hook", method: http.MethodGet, url: "/pets/3", code: http.StatusNotFound},
+		{name: "default", method: http.MethodGet, url: "/pets/4", code: http.StatusInternalServerError, body: `{"code":500,"message":"database unavailable"}`},
+		{name: "no content", method: http.MethodDelete, url: "/pets/1", code: http.StatusConflict},
+		{name: "range", method: http.MethodDelete, url: "/pets/2", code: http.StatusServiceUnavailable, body: `{"code":503,"message":"maintenance"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, httptest.NewRequest(tt.method, tt.url, nil))
+
+			assert.Equal(t, tt.code, rr.Code)
+			if tt.body == "" {
+				assert.Empty(t, rr.Body.String())
+			} else {
+				assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+				assert.JSONEq(t, tt.body, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestResponseErrorHandlerDefault(t *testing.T) {
+	rr := httptest.NewRecorder()
+	Handler(server{}).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/pets/4", nil))
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.Empty(t, rr.Body.String())
+}
+
+func TestErrorResponse(t *testing.T) {
+	assert.Nil(t, GetPetErrorResponse(http.StatusNotFound, Error{}))
+	assert.Equal(t, http.StatusTeapot, GetPetErrorResponse(http.StatusTeapot, Error{}).Code)
+	assert.Nil(t, DeletePetErrorResponse(http.StatusBadRequest, Error{}))
+	assert.Equal(t, http.StatusBadGateway, DeletePetErrorResponse(http.StatusBadGateway, Error{}).Code)
+}
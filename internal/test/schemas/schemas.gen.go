@@ -10,18 +10,21 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
 
+	"gopkg.in/yaml.v3"
+
 	customAlias "github.com/discord-gophers/goapi-gen/internal/test/schemas/types/alias"
 	"github.com/discord-gophers/goapi-gen/internal/test/schemas/types/normal"
 	"github.com/discord-gophers/goapi-gen/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/render"
 )
 
 const (
@@ -37,6 +40,90 @@ var (
 	EnumInObjInArrayValSecond = EnumInObjInArrayVal{"second"}
 )
 
+// AllEnumInObjInArrayValValues returns all values of EnumInObjInArrayVal.
+func AllEnumInObjInArrayValValues() []EnumInObjInArrayVal {
+	return []EnumInObjInArrayVal{
+		EnumInObjInArrayValFirst,
+		EnumInObjInArrayValSecond,
+	}
+}
+
+// Defines values for PetSize.
+var (
+	UnknownPetSize = PetSize{}
+
+	PetSizeSmall = PetSize{"small"}
+
+	PetSizeLarge = PetSize{"large"}
+)
+
+// AllPetSizeValues returns all values of PetSize.
+func AllPetSizeValues() []PetSize {
+	return []PetSize{
+		PetSizeSmall,
+		PetSizeLarge,
+	}
+}
+
+// Defines values for PetKind.
+var (
+	UnknownPetKind = PetKind{}
+
+	PetKindCat = PetKind{"cat"}
+
+	PetKindDog = PetKind{"dog"}
+)
+
+// AllPetKindValues returns all values of PetKind.
+func AllPetKindValues() []PetKind {
+	return []PetKind{
+		PetKindCat,
+		PetKindDog,
+	}
+}
+
+// Defines values for PetStatus.
+var (
+	UnknownPetStatus = PetStatus{}
+
+	// The pet can be bought.
+	PetStatusForSale = PetStatus{"available"}
+
+	// The pet is reserved for a buyer.
+	PetStatusReserved = PetStatus{"pending"}
+
+	PetStatusSold = PetStatus{"sold"}
+)
+
+// AllPetStatusValues returns all values of PetStatus.
+func AllPetStatusValues() []PetStatus {
+	return []PetStatus{
+		PetStatusForSale,
+		PetStatusReserved,
+		PetStatusSold,
+	}
+}
+
+// Defines values for Priority.
+var (
+	UnknownPriority = Priority{}
+
+	PriorityLow = Priority{1}
+
+	PriorityMedium = Priority{2}
+
+	PriorityHigh = Priority{3}
+)
+
+// AllPriorityValues returns all values of Priority.
+func AllPriorityValues() []Priority {
+	return []Priority{
+		PriorityLow,
+		PriorityMedium,
+		PriorityHigh,
+	}
+}
+
 // This schema name starts with a number
 type N5startsWithNumber map[string]interface{}
 
@@ -65,6 +152,12 @@ type EnumInObjInArray []struct {
 // GenericObject defines model for GenericObject.
 type GenericObject map[string]interface{}
 
+// Note defines model for Note.
+type Note struct {
+	Tags  []string `json:"tags,omitempty"`
+	Title string   `json:"title"`
+}
+
 // NullableProperties defines model for NullableProperties.
 type NullableProperties struct {
 	Optional            *string `json:"optional,omitempty"`
@@ -73,6 +166,12 @@ type NullableProperties struct {
 	RequiredAndNullable *string `json:"requiredAndNullable"`
 }
 
+// Pet defines model for Pet.
+type Pet struct {
+	Kind PetKind `json:"kind"`
+	Size PetSize `json:"size"`
+}
+
 // StringInPath defines model for StringInPath.
 type StringInPath string
 
@@ -84,6 +183,21 @@ type EnumInObjInArrayVal struct {
 func (t *EnumInObjInArrayVal) ToValue() string {
 	return t.value
 }
+func (t EnumInObjInArrayVal) String() string {
+	return fmt.Sprint(t.value)
+}
+func (t EnumInObjInArrayVal) IsValid() bool {
+	switch t.value {
+
+	case EnumInObjInArrayValFirst.value:
+		return true
+
+	case EnumInObjInArrayValSecond.value:
+		return true
+
+	}
+	return false
+}
 func (t EnumInObjInArrayVal) MarshalJSON() ([]byte, error) {
 	return json.Marshal(t.value)
 }
@@ -94,6 +208,12 @@ func (t *EnumInObjInArrayVal) UnmarshalJSON(data []byte) error {
 	}
 	return t.FromValue(value)
 }
+func (t EnumInObjInArrayVal) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+func (t *EnumInObjInArrayVal) UnmarshalText(data []byte) error {
+	return t.FromValue(string(data))
+}
 func (t *EnumInObjInArrayVal) FromValue(value string) error {
 	switch value {
 
@@ -109,6 +229,231 @@ func (t *EnumInObjInArrayVal) FromValue(value string) error {
 	return fmt.Errorf("unknown enum value: %v", value)
 }
 
+// PetSize defines model for Pet.Size.
+type PetSize struct {
+	value string
+}
+
+func (t *PetSize) ToValue() string {
+	return t.value
+}
+func (t PetSize) String() string {
+	return fmt.Sprint(t.value)
+}
+func (t PetSize) IsValid() bool {
+	return true
+}
+func (t PetSize) IsKnown() bool {
+	switch t.value {
+
+	case PetSizeLarge.value:
+		return true
+
+	case PetSizeSmall.value:
+		return true
+
+	}
+	return false
+}
+func (t PetSize) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *PetSize) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t PetSize) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+func (t *PetSize) UnmarshalText(data []byte) error {
+	return t.FromValue(string(data))
+}
+func (t *PetSize) FromValue(value string) error {
+	t.value = value
+	return nil
+}
+
+// PetKind defines model for PetKind.
+type PetKind struct {
+	value string
+}
+
+func (t *PetKind) ToValue() string {
+	return t.value
+}
+func (t PetKind) String() string {
+	return fmt.Sprint(t.value)
+}
+func (t PetKind) IsValid() bool {
+	return true
+}
+func (t PetKind) IsKnown() bool {
+	switch t.value {
+
+	case PetKindCat.value:
+		return true
+
+	case PetKindDog.value:
+		return true
+
+	}
+	return false
+}
+func (t PetKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *PetKind) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t PetKind) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+func (t *PetKind) UnmarshalText(data []byte) error {
+	return t.FromValue(string(data))
+}
+func (t *PetKind) FromValue(value string) error {
+	t.value = value
+	return nil
+}
+
+// PetStatus defines model for PetStatus.
+type PetStatus struct {
+	value string
+}
+
+func (t *PetStatus) ToValue() string {
+	return t.value
+}
+func (t PetStatus) String() string {
+	return fmt.Sprint(t.value)
+}
+func (t PetStatus) IsValid() bool {
+	switch t.value {
+
+	case PetStatusForSale.value:
+		return true
+
+	case PetStatusReserved.value:
+		return true
+
+	case PetStatusSold.value:
+		return true
+
+	}
+	return false
+}
+func (t PetStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *PetStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t PetStatus) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+func (t *PetStatus) UnmarshalText(data []byte) error {
+	return t.FromValue(string(data))
+}
+func (t *PetStatus) FromValue(value string) error {
+	switch value {
+
+	case PetStatusForSale.value:
+		t.value = value
+		return nil
+
+	case PetStatusReserved.value:
+		t.value = value
+		return nil
+
+	case PetStatusSold.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Priority defines model for Priority.
+type Priority struct {
+	value int
+}
+
+func (t *Priority) ToValue() int {
+	return t.value
+}
+func (t Priority) String() string {
+	return fmt.Sprint(t.value)
+}
+func (t Priority) IsValid() bool {
+	switch t.value {
+
+	case PriorityHigh.value:
+		return true
+
+	case PriorityLow.value:
+		return true
+
+	case PriorityMedium.value:
+		return true
+
+	}
+	return false
+}
+func (t Priority) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *Priority) UnmarshalJSON(data []byte) error {
+	var value int
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t Priority) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+func (t *Priority) UnmarshalText(data []byte) error {
+	var value int
+	if err := runtime.BindStringToObject(string(data), &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *Priority) FromValue(value int) error {
+	switch value {
+
+	case PriorityHigh.value:
+		t.value = value
+		return nil
+
+	case PriorityLow.value:
+		t.value = value
+		return nil
+
+	case PriorityMedium.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// GetByStatusParams defines parameters for GetByStatus.
+type GetByStatusParams struct {
+	Priority *Priority `json:"priority,omitempty"`
+}
+
 // Issue185JSONBody defines parameters for Issue185.
 type Issue185JSONBody NullableProperties
 
@@ -120,6 +465,21 @@ type Issue9Params struct {
 	Foo string `json:"foo"`
 }
 
+// CreateNoteJSONBody defines parameters for CreateNote.
+type CreateNoteJSONBody Note
+
+// CreateNoteBinaryBody defines parameters for CreateNote.
+type CreateNoteBinaryBody []byte
+
+// CreateNoteXMLBody defines parameters for CreateNote.
+type CreateNoteXMLBody Note
+
+// CreateNoteYAMLBody defines parameters for CreateNote.
+type CreateNoteYAMLBody Note
+
+// CreateNoteTextBody defines parameters for CreateNote.
+type CreateNoteTextBody string
+
 // GetPr66Params defines parameters for GetPr66.
 type GetPr66Params struct {
 	Foo normal.CustomGoType `json:"foo"`
@@ -141,6 +501,36 @@ func (Issue185JSONRequestBody) Bind(*http.Request) error {
 // Issue9JSONRequestBody defines body for Issue9 for application/json ContentType.
 type Issue9JSONRequestBody Issue9JSONBody
 
+// CreateNoteJSONRequestBody defines body for CreateNote for application/json ContentType.
+type CreateNoteJSONRequestBody CreateNoteJSONBody
+
+// Bind implements render.Binder.
+func (CreateNoteJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// CreateNoteBinaryRequestBody defines body for CreateNote for application/octet-stream ContentType.
+type CreateNoteBinaryRequestBody CreateNoteBinaryBody
+
+// CreateNoteXMLRequestBody defines body for CreateNote for application/xml ContentType.
+type CreateNoteXMLRequestBody CreateNoteXMLBody
+
+// Bind implements render.Binder.
+func (CreateNoteXMLRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// CreateNoteYAMLRequestBody defines body for CreateNote for application/yaml ContentType.
+type CreateNoteYAMLRequestBody CreateNoteYAMLBody
+
+// Bind implements render.Binder.
+func (CreateNoteYAMLRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// CreateNoteTextRequestBody defines body for CreateNote for text/plain ContentType.
+type CreateNoteTextRequestBody CreateNoteTextBody
+
 // Response is a common response struct for all the API calls.
 // A Response object may be instantiated via functions for specific operation responses.
 // It may also be instantiated directly, for the purpose of responding with a single status code.
@@ -148,13 +538,62 @@ type Response struct {
 	body        interface{}
 	Code        int
 	contentType string
+	offers      []string
+	raw         bool
 }
 
-// Render implements the render.Renderer interface. It sets the Content-Type header
-// and status code based on the response definition.
+// Render writes the response. It sets the Content-Type header and status code
+// based on the response definition, and writes the body encoded for its content type.
+// Raw bodies, such as text/plain or image/png, are written as is.
+// Nothing is written if the body can't be encoded.
+//
+// Responses with several representations are written in the one which best
+// matches the Accept header of the request, or in the default content type
+// when none is acceptable.
 func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
-	w.Header().Set("Content-Type", resp.contentType)
-	render.Status(r, resp.Code)
+	contentType := resp.contentType
+	if accept := r.Header.Get("Accept"); accept != "" && len(resp.offers) > 0 {
+		offers := append([]string{contentType}, resp.offers...)
+		if ct := runtime.NegotiateContentType(accept, offers...); ct != "" {
+			contentType = ct
+		}
+	}
+
+	if resp.raw {
+		w.Header().Set("Content-Type", contentType)
+		w.WriteHeader(resp.Code)
+		switch body := resp.body.(type) {
+		case string:
+			io.WriteString(w, body)
+		case []byte:
+			w.Write(body)
+		case io.Reader:
+			if c, ok := body.(io.Closer); ok {
+				defer c.Close()
+			}
+			io.Copy(w, body)
+		}
+		return nil
+	}
+
+	var data []byte
+	var err error
+	switch {
+	case strings.Contains(contentType, "xml"):
+		data, err = xml.Marshal(resp)
+		data = append([]byte(xml.Header), data...)
+	case strings.Contains(contentType, "yaml"):
+		data, err = runtime.MarshalYAML(resp.body)
+	default:
+		data, err = json.Marshal(resp.body)
+	}
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(resp.Code)
+	w.Write(data)
 	return nil
 }
 
@@ -165,6 +604,8 @@ func (resp *Response) Status(code int) *Response {
 }
 
 // ContentType is a builder method to override the default content type for a response.
+// The default content type of a response with several representations is
+// used when the Accept header of the request allows none of them.
 func (resp *Response) ContentType(contentType string) *Response {
 	resp.contentType = contentType
 	return resp
@@ -200,6 +641,16 @@ func EnsureEverythingIsReferencedJSON200Response(body struct {
 	}
 }
 
+// GetByStatusJSON200Response is a constructor method for a GetByStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetByStatusJSON200Response(body Pet) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
 // Issue127JSON200Response is a constructor method for a Issue127 response.
 // A *Response is returned with the configured status code and content type from the spec.
 func Issue127JSON200Response(body GenericObject) *Response {
@@ -220,6 +671,17 @@ func Issue127XML200Response(body GenericObject) *Response {
 	}
 }
 
+// Issue127TextMarkdown200Response is a constructor method for a Issue127 response.
+// A *Response is returned with the configured status code and content type from the spec.
+func Issue127TextMarkdown200Response(body []byte) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "text/markdown",
+		raw:         true,
+	}
+}
+
 // Issue127YAML200Response is a constructor method for a Issue127 response.
 // A *Response is returned with the configured status code and content type from the spec.
 func Issue127YAML200Response(body GenericObject) *Response {
@@ -240,6 +702,29 @@ func Issue127JSONDefaultResponse(body GenericObject) *Response {
 	}
 }
 
+// Issue127TextMarkdownDefaultResponse is a constructor method for a Issue127 response.
+// A *Response is returned with the configured status code and content type from the spec.
+func Issue127TextMarkdownDefaultResponse(body []byte) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "text/markdown",
+		raw:         true,
+	}
+}
+
+// Issue127200Response is a constructor method for a Issue127 response.
+// A *Response is returned with the configured status code from the spec, and the content type
+// which best matches the Accept header of the request, application/json by default.
+func Issue127200Response(body GenericObject) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+		offers:      []string{"application/json", "application/xml", "text/yaml"},
+	}
+}
+
 // GetIssues375JSON200Response is a constructor method for a GetIssues375 response.
 // A *Response is returned with the configured status code and content type from the spec.
 func GetIssues375JSON200Response(body EnumInObjInArray) *Response {
@@ -250,6 +735,48 @@ func GetIssues375JSON200Response(body EnumInObjInArray) *Response {
 	}
 }
 
+// GetNoteJSON200Response is a constructor method for a GetNote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetNoteJSON200Response(body Note) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetNoteXML200Response is a constructor method for a GetNote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetNoteXML200Response(body Note) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/xml",
+	}
+}
+
+// GetNoteYAML200Response is a constructor method for a GetNote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetNoteYAML200Response(body Note) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/yaml",
+	}
+}
+
+// GetNote200Response is a constructor method for a GetNote response.
+// A *Response is returned with the configured status code from the spec, and the content type
+// which best matches the Accept header of the request, application/json by default.
+func GetNote200Response(body Note) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+		offers:      []string{"application/json", "application/xml", "application/yaml"},
+	}
+}
+
 // GetPr66JSON200Response is a constructor method for a GetPr66 response.
 // A *Response is returned with the configured status code and content type from the spec.
 func GetPr66JSON200Response(body CustomGoType) *Response {
@@ -270,12 +797,25 @@ func PostPr66JSON200Response(body CustomGoTypeWithAlias) *Response {
 	}
 }
 
+// defaultBaseURL is the base URL of the server, the path of the URL of the
+// first server of the specification.
+const defaultBaseURL = "/"
+
+// ServerURL0 returns the URL of the server
+// http://example.com.
+func ServerURL0() string {
+	return "http://example.com"
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
 	// (GET /ensure-everything-is-referenced)
 	EnsureEverythingIsReferenced(w http.ResponseWriter, r *http.Request) *Response
 
+	// (GET /enums/{status})
+	GetByStatus(w http.ResponseWriter, r *http.Request, status PetStatus, params GetByStatusParams) *Response
+
 	// (GET /issues/127)
 	Issue127(w http.ResponseWriter, r *http.Request) *Response
 
@@ -297,6 +837,12 @@ type ServerInterface interface {
 	// (GET /issues/9)
 	Issue9(w http.ResponseWriter, r *http.Request, params Issue9Params) *Response
 
+	// (POST /notes)
+	CreateNote(w http.ResponseWriter, r *http.Request) *Response
+
+	// (GET /notes/{id})
+	GetNote(w http.ResponseWriter, r *http.Request, id int) *Response
+
 	// (GET /pr/66)
 	GetPr66(w http.ResponseWriter, r *http.Request, params GetPr66Params) *Response
 
@@ -308,19 +854,79 @@ type ServerInterface interface {
 type ServerInterfaceWrapper struct {
 	Handler          ServerInterface
 	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	Observer         runtime.Observer
 }
 
 // EnsureEverythingIsReferenced operation middleware
 func (siw *ServerInterfaceWrapper) EnsureEverythingIsReferenced(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "EnsureEverythingIsReferenced", "/ensure-everything-is-referenced")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	ctx = context.WithValue(ctx, AccessTokenScopes, []string{""})
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.EnsureEverythingIsReferenced(w, r)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetByStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetByStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetByStatus", "/enums/{status}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
+	// ------------- Path parameter "status" -------------
+	var status PetStatus
+
+	if err := runtime.BindStyledParameter("simple", false, "status", chi.URLParam(r, "status"), &status); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "status"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, AccessTokenScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetByStatusParams
+
+	query := r.URL.Query()
+
+	// ------------- Optional query parameter "priority" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "priority", query, &params.Priority); err != nil {
+		err = fmt.Errorf("invalid format for parameter priority: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "priority"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetByStatus(w, r, status, params)
+		if resp != nil {
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -334,13 +940,22 @@ func (siw *ServerInterfaceWrapper) EnsureEverythingIsReferenced(w http.ResponseW
 func (siw *ServerInterfaceWrapper) Issue127(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "Issue127", "/issues/127")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	ctx = context.WithValue(ctx, AccessTokenScopes, []string{""})
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.Issue127(w, r)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -354,13 +969,22 @@ func (siw *ServerInterfaceWrapper) Issue127(w http.ResponseWriter, r *http.Reque
 func (siw *ServerInterfaceWrapper) Issue185(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "Issue185", "/issues/185")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	ctx = context.WithValue(ctx, AccessTokenScopes, []string{""})
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.Issue185(w, r)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -374,6 +998,13 @@ func (siw *ServerInterfaceWrapper) Issue185(w http.ResponseWriter, r *http.Reque
 func (siw *ServerInterfaceWrapper) Issue209(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "Issue209", "/issues/209/${str}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "str" -------------
 	var str StringInPath
 
@@ -387,8 +1018,10 @@ func (siw *ServerInterfaceWrapper) Issue209(w http.ResponseWriter, r *http.Reque
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.Issue209(w, r, str)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -402,12 +1035,21 @@ func (siw *ServerInterfaceWrapper) Issue209(w http.ResponseWriter, r *http.Reque
 func (siw *ServerInterfaceWrapper) Issue30(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "Issue30", "/issues/30/{fallthrough}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "fallthrough" -------------
 	var pFallthrough string
 
-	if err := runtime.BindStyledParameter("simple", false, "fallthrough", chi.URLParam(r, "fallthrough"), &pFallthrough); err != nil {
+	if raw, err := runtime.StyledParameterValue("simple", false, "fallthrough", chi.URLParam(r, "fallthrough")); err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "fallthrough"})
 		return
+	} else {
+		pFallthrough = raw
 	}
 
 	ctx = context.WithValue(ctx, AccessTokenScopes, []string{""})
@@ -415,8 +1057,10 @@ func (siw *ServerInterfaceWrapper) Issue30(w http.ResponseWriter, r *http.Reques
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.Issue30(w, r, pFallthrough)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -430,13 +1074,22 @@ func (siw *ServerInterfaceWrapper) Issue30(w http.ResponseWriter, r *http.Reques
 func (siw *ServerInterfaceWrapper) GetIssues375(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetIssues375", "/issues/375")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	ctx = context.WithValue(ctx, AccessTokenScopes, []string{""})
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetIssues375(w, r)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -450,6 +1103,13 @@ func (siw *ServerInterfaceWrapper) GetIssues375(w http.ResponseWriter, r *http.R
 func (siw *ServerInterfaceWrapper) Issue41(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "Issue41", "/issues/41/{1param}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "1param" -------------
 	var n1param N5startsWithNumber
 
@@ -463,8 +1123,10 @@ func (siw *ServerInterfaceWrapper) Issue41(w http.ResponseWriter, r *http.Reques
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.Issue41(w, r, n1param)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -478,24 +1140,109 @@ func (siw *ServerInterfaceWrapper) Issue41(w http.ResponseWriter, r *http.Reques
 func (siw *ServerInterfaceWrapper) Issue9(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "Issue9", "/issues/9")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	ctx = context.WithValue(ctx, AccessTokenScopes, []string{""})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params Issue9Params
 
+	query := r.URL.Query()
+
 	// ------------- Required query parameter "foo" -------------
 
-	if err := runtime.BindQueryParameter("form", true, true, "foo", r.URL.Query(), &params.Foo); err != nil {
+	if raw, found, err := runtime.QueryParameterValue("form", true, "foo", query); err != nil {
 		err = fmt.Errorf("invalid format for parameter foo: %w", err)
 		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "foo"})
 		return
+	} else if found {
+		params.Foo = raw
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{paramName: "foo"})
+		return
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.Issue9(w, r, params)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CreateNote operation middleware
+func (siw *ServerInterfaceWrapper) CreateNote(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "CreateNote", "/notes")
+		defer call.End()
+		ctx = r.Context()
+	}
+
+	ctx = context.WithValue(ctx, AccessTokenScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.CreateNote(w, r)
+		if resp != nil {
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetNote operation middleware
+func (siw *ServerInterfaceWrapper) GetNote(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetNote", "/notes/{id}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	if raw, err := runtime.StyledParameterValue("simple", false, "id", chi.URLParam(r, "id")); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "id"})
+		return
+	} else if id, err = runtime.ParseInt(raw); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "id"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, AccessTokenScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetNote(w, r, id)
+		if resp != nil {
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -509,14 +1256,23 @@ func (siw *ServerInterfaceWrapper) Issue9(w http.ResponseWriter, r *http.Request
 func (siw *ServerInterfaceWrapper) GetPr66(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetPr66", "/pr/66")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	ctx = context.WithValue(ctx, AccessTokenScopes, []string{""})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetPr66Params
 
+	query := r.URL.Query()
+
 	// ------------- Required query parameter "foo" -------------
 
-	if err := runtime.BindQueryParameter("form", true, true, "foo", r.URL.Query(), &params.Foo); err != nil {
+	if err := runtime.BindQueryParameter("form", true, true, "foo", query, &params.Foo); err != nil {
 		err = fmt.Errorf("invalid format for parameter foo: %w", err)
 		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "foo"})
 		return
@@ -525,8 +1281,10 @@ func (siw *ServerInterfaceWrapper) GetPr66(w http.ResponseWriter, r *http.Reques
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetPr66(w, r, params)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -540,14 +1298,23 @@ func (siw *ServerInterfaceWrapper) GetPr66(w http.ResponseWriter, r *http.Reques
 func (siw *ServerInterfaceWrapper) PostPr66(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "PostPr66", "/pr/66")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	ctx = context.WithValue(ctx, AccessTokenScopes, []string{""})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params PostPr66Params
 
+	query := r.URL.Query()
+
 	// ------------- Required query parameter "bar" -------------
 
-	if err := runtime.BindQueryParameter("form", true, true, "bar", r.URL.Query(), &params.Bar); err != nil {
+	if err := runtime.BindQueryParameter("form", true, true, "bar", query, &params.Bar); err != nil {
 		err = fmt.Errorf("invalid format for parameter bar: %w", err)
 		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "bar"})
 		return
@@ -556,8 +1323,10 @@ func (siw *ServerInterfaceWrapper) PostPr66(w http.ResponseWriter, r *http.Reque
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.PostPr66(w, r, params)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -657,17 +1426,33 @@ type ServerOptions struct {
 	BaseURL          string
 	BaseRouter       chi.Router
 	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	Observer         runtime.Observer
+	SpecRoute        string
+	SpecUIRoute      string
+	SpecUI           func(specURL string) http.Handler
 }
 
 type ServerOption func(*ServerOptions)
 
 // Handler creates http.Handler with routing matching OpenAPI spec.
+// It panics if the options are invalid, such as when a tagged middleware is missing.
 func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
+	h, err := NewHandler(si, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// NewHandler creates http.Handler with routing matching OpenAPI spec.
+// An error is returned if the options are invalid, such as when a tagged middleware is missing.
+func NewHandler(si ServerInterface, opts ...ServerOption) (http.Handler, error) {
 	options := &ServerOptions{
-		BaseURL:    "/",
+		BaseURL:    defaultBaseURL,
 		BaseRouter: chi.NewRouter(),
 		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			status := http.StatusBadRequest
+			http.Error(w, err.Error(), status)
 		},
 	}
 
@@ -679,10 +1464,23 @@ func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
 	wrapper := ServerInterfaceWrapper{
 		Handler:          si,
 		ErrorHandlerFunc: options.ErrorHandlerFunc,
+		Observer:         options.Observer,
+	}
+
+	// Errors, such as parameter binding errors, are recorded on the observed
+	// call of the operation.
+	wrapper.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+		runtime.SetCallError(r.Context(), err)
+		options.ErrorHandlerFunc(w, r, err)
+	}
+
+	if options.SpecUI != nil && options.SpecRoute == "" {
+		return nil, errors.New("goapi-gen: WithSpecUI requires WithSpecRoute")
 	}
 
 	r.Route(options.BaseURL, func(r chi.Router) {
 		r.Get("/ensure-everything-is-referenced", wrapper.EnsureEverythingIsReferenced)
+		r.Get("/enums/{status}", wrapper.GetByStatus)
 		r.Get("/issues/127", wrapper.Issue127)
 		r.Get("/issues/185", wrapper.Issue185)
 		r.Get("/issues/209/${str}", wrapper.Issue209)
@@ -690,10 +1488,21 @@ func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
 		r.Get("/issues/375", wrapper.GetIssues375)
 		r.Get("/issues/41/{1param}", wrapper.Issue41)
 		r.Get("/issues/9", wrapper.Issue9)
+		r.Post("/notes", wrapper.CreateNote)
+		r.Get("/notes/{id}", wrapper.GetNote)
 		r.Get("/pr/66", wrapper.GetPr66)
 		r.Post("/pr/66", wrapper.PostPr66)
+
+		if options.SpecRoute != "" {
+			r.Method(http.MethodGet, options.SpecRoute, SpecHandler(opts...))
+		}
+		if options.SpecUI != nil {
+			ui := options.SpecUI(path.Join(options.BaseURL, options.SpecRoute))
+			r.Method(http.MethodGet, options.SpecUIRoute, ui)
+			r.Method(http.MethodGet, strings.TrimSuffix(options.SpecUIRoute, "/")+"/*", ui)
+		}
 	})
-	return r
+	return r, nil
 }
 
 func WithRouter(r chi.Router) ServerOption {
@@ -714,33 +1523,128 @@ func WithErrorHandler(handler func(w http.ResponseWriter, r *http.Request, err e
 	}
 }
 
+// WithObserver reports each call of an operation to o once it returns, with
+// its operation ID, method, route template, status code, response size,
+// duration and the error passed to the error handler, if any.
+func WithObserver(o runtime.Observer) ServerOption {
+	return func(s *ServerOptions) {
+		s.Observer = o
+	}
+}
+
+// WithSpecRoute serves the embedded OpenAPI specification at pattern, relative
+// to the base URL. See SpecHandler.
+func WithSpecRoute(pattern string) ServerOption {
+	return func(s *ServerOptions) {
+		s.SpecRoute = pattern
+	}
+}
+
+// WithSpecUI serves an API explorer for the specification served by
+// WithSpecRoute at pattern, relative to the base URL. ui creates the
+// explorer's handler from the URL of the specification, for example
+// specui.SwaggerUI.
+func WithSpecUI(pattern string, ui func(specURL string) http.Handler) ServerOption {
+	return func(s *ServerOptions) {
+		s.SpecUIRoute = pattern
+		s.SpecUI = ui
+	}
+}
+
+// SpecHandler returns an http.Handler serving the embedded OpenAPI
+// specification as JSON, or as YAML if the Accept header prefers it. The
+// servers of the specification are replaced by the request's host and the
+// base URL of opts.
+func SpecHandler(opts ...ServerOption) http.Handler {
+	options := &ServerOptions{
+		BaseURL: defaultBaseURL,
+	}
+	for _, f := range opts {
+		f(options)
+	}
+
+	swagger, loadErr := GetSwagger()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if loadErr != nil {
+			http.Error(w, loadErr.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		scheme := "http"
+		if r.TLS != nil {
+			scheme = "https"
+		}
+		if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+			scheme = proto
+		}
+
+		spec := *swagger
+		spec.Servers = openapi3.Servers{
+			{URL: scheme + "://" + r.Host + strings.TrimSuffix(options.BaseURL, "/")},
+		}
+		data, err := json.Marshal(&spec)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		contentType := runtime.NegotiateContentType(r.Header.Get("Accept"),
+			"application/json", "application/yaml", "application/x-yaml", "text/yaml")
+		switch contentType {
+		case "application/yaml", "application/x-yaml", "text/yaml":
+			var v interface{}
+			if err := yaml.Unmarshal(data, &v); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			if data, err = yaml.Marshal(v); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		default:
+			contentType = "application/json"
+		}
+
+		w.Header().Set("Content-Type", contentType)
+		w.Write(data)
+	})
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xYT2/buBL/KgRb4F1ky0nbtPEtr+gr8oBtgyZAD3EOtDi22EgkS46SCIa++2JIybJj",
-	"2dtsUuxlc7Ekcv79ZvibYVY8M6U1GjR6Pl1xK5woAcGFt0t0Si/P9YXAnN4l+Mwpi8poPuVnzId1ZgXm",
-	"bC3JE65omb7yhGtRAp9yj7Tg4GelHEg+RVdBwn2WQylINda23ab0kjdN0y0GR95donDovyvMv1TlHNyu",
-	"N1e58iyKMLLJfBBh9wpzJpiOYklnyMx/QIa8SfiZrq9qC0d8uurfjgfCbVeYA+vAE2JM6JqRwvFMz3T0",
-	"IDdVIdkcmNBMaQS3EBmsmpkmWx8rj6b8bK6CE4+jTvjDaGlG2C6q0hqHfMqXCvNqPs5MmUrlM+PkaGls",
-	"Ds6nSyOsGi1Bp8GWFkWK4DFtsUtJl0+1caUo+uC33Gge+UUgnxUqAn/QQRF38SxIR5nkBd0WrcbDXsci",
-	"7RBdUKi4dqqX3g4BHtCJEYqlj0KGT/lcuKD2k67Kc/11/uNcnzkn6pALhDIeEGcsOFQQ3u5EQT+gq5JP",
-	"r/lCOY884R4yoyW/SXbKeqD+2g8imGoS/hk0OJV9jRv6HPQSX6qiEPMCLrZ82fbMhLKN7j1yIlkvnmnZ",
-	"6aJ9ev0cT+eOXH98V/sXn6Z0S+t1/zys72YHvyagXTmF9SUVT4xeZBl4P0JzC5re5yAcuP91tfH/71ej",
-	"WCgs7mRh53imeUs7ZCIK9RWUI9rITEovzAADgUeWCQ+eLYxjd8IpU3mmvK/Cp0pLZu7AMVQljNlFAcID",
-	"E1IywbCTJdGZJl6ZV0u2UA8go1uokECMVi7B3QXX7sD5aP1oPBlPYnJBC6v4lL8ZT8ZHPAlMHGBJQfvK",
-	"wQjuwNWYK70cKT9ysAAHOot5XQLuIVfQ0hqlkcGD8uiZNwxzgazvICwTmqgvcyAQJFOaYa78THsLGRNa",
-	"Mm2QNlhXaZAhLipaQWbOJZ/yT8HBT2v/zv233juqCW+N9jHJx5MJ/WRGI+jgtLC2UFnQlv7wJqS+bzHb",
-	"B0T0tM9fO1jwKX+V9qGsqWjdHpqkkzn+RZljkskGSOqQ7A6pDZBG/Et4GmsrPTp+vzd1f4hbYAQqq7Sv",
-	"LHEzSBZAe8DQvDyTRv8HmXUApUXW7wqr44E0nZNdsvrMlBwCYpsHKdxNXQ9l8RxVFHxaCncrzb1+tqJa",
-	"PMcbUiNhIaoCfyN4LxTx48r78G4/adQW2JLkQwTsPgfNutaTdvTO+mPJhAPW9Yv9ZffhXdsdwON/jaxf",
-	"DLSBvhqj3ahxcm8TgOPJafp65dE1e3H4mEN265la9DNyDFVCVogegqIeDvh4csp3fUi2ZvXr4cj6LenW",
-	"LN/cbITwZpKuFqIoMHemWubNbgTfwFPDkewW6nvj5OaYax2ELkVkTy2PAAwDeEscLSQDcb2Z/EpYA3eJ",
-	"DWefdKfYCvr9/sKlAbBNTlu5wneFTKx4rzKgdGIOjEa/sK40TfyRoWf6PldZ3n73SgIzC1oOQ95QZX8G",
-	"DJh48us3kurObLtzot8epaujkIP9FX3RpWjjpkUXwXDXWt+0BlL+No4jf5XgaP9gbg8FuXtbbJqbg6f4",
-	"dP/hLRRojCfXh4bIlM6Mc5BhUdNzUUmQYeJrOSnCMDeyppFnpvt493La6R5Yflbg6o3CN+ZpBf+3ebJt",
-	"SptIfG2ZO0TG97CidenJyeExUvlu3iXMbFUUa+BenZzsORwX7uTkd2D0T125H9Xjyx7yx7ZCMq3xL5eR",
-	"C+OfkBK6WP+bkoF/sDRr9u3usAHD7dvr9Q15Fhpwi3HlivY6Ok1TeBClLYBwobb+ZwAAAP//VWIshNET",
-	"AAA=",
+	"H4sIAAAAAAAC/+xZT3PbthL/Khi0M+9CiZLTuI1ubibN8+tr4ok904OtA0SsSMQkwAJL23waffc3C5Ci",
+	"JJKO48TTS3OxJACL3/777WKz4YkpSqNBo+OLDS+FFQUgWP/tEq3S6bm+EJjRdwkusapEZTRf8DPm/Dor",
+	"BWZsd5JHXNEy/cojrkUBfMEd0oKFvyplQfIF2goi7pIMCkGisS6bbUqnfLvdtoseyOtLFBbdnwqzD1Wx",
+	"AttHc5Upx8IRRncy54+we4UZE0yHY1F7kVl9hgT5NuJnur6qS5jzxab7djKgbrPCLJQWHFmMCV0zEji9",
+	"0Tc6IMhMlUu2AiY0UxrBrkUCm+2NprveVg5N8d5ceRDHWkf8YZKaCTaLqiiNRb7gqcKsWk0TU8RSucRY",
+	"OUlNmYF1cWpEqSYp6NjfpUUeIziMG9vFJMvF2thC5J3yBzC2R7jIyGe5CoZ/FKAIu3jiT4cz0XeELRqJ",
+	"j6MOQdpadE2q4g4Uj4ZVgAe0YoIideGQ4Qu+EtaLfaer4lx/XH0+12fWitr7AqEICWJNCRYV+G93Iqc/",
+	"oKuCL675WlmHPOIOEqMlX0a9sB6Iv+YH4a/aRvw9aLAq+Rg2LDb9Ex8MQh9Lq8wO6tHd/ZtQYQ5Dybef",
+	"qNfNtuUA9A9VnotVDhcHQA5hGZ8/wU49RO3imZatLNqnd58DTfTOdfA244tfJ/RI6d3nYXlD1rgA7Kt/",
+	"q7RH+aOFNV/wH+KOb3fRfgH4O20jzlP/g/2IcoXIKXFzYVPgy6FohgcE7dQqh0k4Reodq+NhNOJHsP/e",
+	"IH3CDdc8EcgjLk3Kl+H0JQqs3D50cSdUMFbES9AySHMmlyN66KqY7FGuIyFXGbASkCVCE6euTJVmOOXR",
+	"bkE5ZsGBvQPJ1sYywVZVDZa28OVO7J2wVBO8yN+MvRQe1afmII/4pYdFqlhlrMJ6T5N5dBK96iArjZD6",
+	"UjIg/L/mnkf8D5CqKnjE/63SjC99MYOkIrmX5PMQGiJJwLkJmlvQ9H0FwoL9rSWw//x5NQlsxsJO5ndO",
+	"bzRvaiOhCYc6mssQy1A+lV6bgTIJjqzpwHlz3QmrTOWYcq7yP1VaMnMHlqEqYMouchAOmJCSCYbtWTp6",
+	"o6n4raqUrdUDyACrYZRwyyUZl6DdgXXh9vl0Np2FxActSsUX/NV0Np1TiAjMvFli0K6yMIE7sDVmSqcT",
+	"5SYW1mBBJyHnU8AB1aj+gpalURoZPCiHjjnDMBPIurRrYymxIBAkU5phptyNdiUkTGjJtEHaUNpKg/R6",
+	"UUYLuuZc8gV/5wG+2+E7d586dBG34EqjXXDyyWxGfxKjEbQHLcoyV4mXFn92xru+64MO2UN0vcmjDLLr",
+	"YbYRF3sdzBPOnNCZZKCSPna2V3kHKlv4F5E/q8LFG+cZYjvqPiq7jgnrs1xLJlzXU7rIO6YQdejtMANF",
+	"wZtX4KYDHnoP+GvdUFJ00NReb4a702breIP6BQJvLttuo+aCvyqwdXdD2fLKk2W2B7bb5TfG1BegB7o4",
+	"ziVPr7xxYaCHeH7y86j7/hC3wCgvWKVdVVIPCJJ5jA/om2THpNH/QlZagKJE1u3yq0N+PKd76dYXtMBh",
+	"v0Xq7st6KPJvEUXKx4Wwt9Lc628WVItvQRO8vBZVji9ovO+k8fYo8n55Pc77dQkspfNeA3afgWZtZxm3",
+	"Gc06ZvUk07aD42H3y+uGEMDhr0bW381oA21z0HYvxgnevgFOZm/iHzcO7TiBvs0guXVMrfd406sqIclF",
+	"Z4K8Hlb4ZPaG9zH06HNIs25LfDAz2C73VHg1izdrkeeYWWrjtn0N2oaM3UJ9b6zcf06XFnyjQfWauhYy",
+	"oC8GDXE0JhnQ69XsKWoNVIU9sF81uzhQ+ufXj1a8xjlN5ArXBjKx4r1KgNyJGTAqon5daZoshCJ7o+8z",
+	"lWTN705JYGZNy/6JN1IYvU0c4XpBUu29oXsZ/dM83sy9D8Yj+qJ10d5EhwZOfqazm+gMuPyn+ZPKfrj/",
+	"2WW/P5U6rtXHWfxmPHlzBRpD5jpfEJnSibEWEsxr+pxXsnnjNJwUzLAysqbm6EZ3+o5y2psRsxw1KzQP",
+	"+aqAfzZPDrQeHxvm9prxEVbUBpthg3E4RCTBQisjie9TQCaCUcl+IJKMNQjb8V3PXm/988CPWl6oCpDo",
+	"43bDJAg4cWhBFIeidoOtldLCO6s/wHh24zKIpRbPEuEbgDIX6sgWAxPewwjb9vhoPvTIA3qfgee85gnX",
+	"dqn0u4s3So4zyqd2dutVdMwEdnVELyGTLDANqUHlOXhtTeF3nCUJlMgyEBLsCLM2wfJl3lHyKenVDhpe",
+	"9gEw6Pq/KXgGnyG6WSMPlzY+PX18AKBcO6mgVC+rPN/x5Q+npyOeu7Cnpy9BjX/XRP9FI+b4Lu+aYR5+",
+	"pkcujPsKl9Dc/h+XDPz/TdNz7U0fvQ0P547XS0Lm++7GxpXNm0HiIo7hQRRlDmQX6ub/PwBu+h8mMBwA",
+	"AA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1,7 +1,17 @@
 package schemas
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/discord-gophers/goapi-gen/runtime"
 )
 
 func TestForCorrectCustomTypes(t *testing.T) {
@@ -10,3 +20,113 @@ func TestForCorrectCustomTypes(t *testing.T) {
 	_ = CustomGoType("example")
 
 }
+
+func TestEnumValues(t *testing.T) {
+	assert.Equal(t, []PetStatus{PetStatusForSale, PetStatusReserved, PetStatusSold}, AllPetStatusValues())
+	assert.Equal(t, "pending", PetStatusReserved.String())
+	assert.True(t, PriorityHigh.IsValid())
+	assert.False(t, UnknownPriority.IsValid())
+
+	text, err := PriorityMedium.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "2", string(text))
+
+	var p Priority
+	require.NoError(t, p.UnmarshalText([]byte("3")))
+	assert.Equal(t, PriorityHigh, p)
+	assert.Error(t, p.UnmarshalText([]byte("4")))
+}
+
+func TestEnumParameters(t *testing.T) {
+	var status PetStatus
+	require.NoError(t, runtime.BindStyledParameter("simple", false, "status", "available", &status))
+	assert.Equal(t, PetStatusForSale, status)
+	assert.Error(t, runtime.BindStyledParameter("simple", false, "status", "adopted", &status))
+
+	var priority *Priority
+	err := runtime.BindQueryParameter("form", true, false, "priority", url.Values{"priority": {"1"}}, &priority)
+	require.NoError(t, err)
+	assert.Equal(t, &PriorityLow, priority)
+}
+
+func TestExtensibleEnums(t *testing.T) {
+	var pet Pet
+	err := json.Unmarshal([]byte(`{"kind":"bird","size":"small"}`), &pet)
+	require.NoError(t, err)
+	assert.False(t, pet.Kind.IsKnown())
+	assert.True(t, pet.Kind.IsValid())
+	assert.Equal(t, "bird", pet.Kind.ToValue())
+	assert.Equal(t, PetSizeSmall, pet.Size)
+	assert.True(t, pet.Size.IsKnown())
+
+	data, err := json.Marshal(pet)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"kind":"bird","size":"small"}`, string(data))
+
+	assert.Equal(t, []PetKind{PetKindCat, PetKindDog}, AllPetKindValues())
+}
+
+func TestNegotiatedResponse(t *testing.T) {
+	note := Note{Title: "groceries", Tags: []string{"todo"}}
+
+	tests := []struct {
+		accept      string
+		contentType string
+		body        string
+	}{
+		{accept: "", contentType: "application/json", body: `{"tags":["todo"],"title":"groceries"}`},
+		{accept: "*/*", contentType: "application/json", body: `{"tags":["todo"],"title":"groceries"}`},
+		{accept: "text/html", contentType: "application/json", body: `{"tags":["todo"],"title":"groceries"}`},
+		{accept: "application/xml", contentType: "application/xml", body: `<?xml version="1.0" encoding="UTF-8"?>` + "\n" + `<Note><Tags>todo</Tags><Title>groceries</Title></Note>`},
+		{accept: "application/json;q=0.5, application/yaml", contentType: "application/yaml", body: "tags:\n    - todo\ntitle: groceries\n"},
+		{accept: "application/*;q=0.9, application/xml;q=0.1", contentType: "application/json", body: `{"tags":["todo"],"title":"groceries"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.accept, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/notes/1", nil)
+			r.Header.Set("Accept", tt.accept)
+			w := httptest.NewRecorder()
+			require.NoError(t, GetNote200Response(note).Render(w, r))
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tt.contentType, w.Header().Get("Content-Type"))
+			assert.Equal(t, tt.body, w.Body.String())
+		})
+	}
+
+	// The default content type is configurable.
+	r := httptest.NewRequest("GET", "/notes/1", nil)
+	r.Header.Set("Accept", "text/html")
+	w := httptest.NewRecorder()
+	require.NoError(t, GetNote200Response(note).ContentType("application/yaml").Render(w, r))
+	assert.Equal(t, "application/yaml", w.Header().Get("Content-Type"))
+}
+
+func TestRequestBodies(t *testing.T) {
+	want := Note{Title: "groceries", Tags: []string{"todo"}}
+	newRequest := func(contentType, body string) *http.Request {
+		r := httptest.NewRequest("POST", "/notes", strings.NewReader(body))
+		r.Header.Set("Content-Type", contentType)
+		return r
+	}
+
+	var jsonBody CreateNoteJSONRequestBody
+	require.NoError(t, runtime.BindBody(newRequest("application/json", `{"title":"groceries","tags":["todo"]}`), &jsonBody))
+	assert.Equal(t, want, Note(jsonBody))
+
+	var xmlBody CreateNoteXMLRequestBody
+	require.NoError(t, runtime.BindBody(newRequest("application/xml", `<Note><Title>groceries</Title><Tags>todo</Tags></Note>`), &xmlBody))
+	assert.Equal(t, want, Note(xmlBody))
+
+	var yamlBody CreateNoteYAMLRequestBody
+	require.NoError(t, runtime.BindBody(newRequest("application/yaml", "title: groceries\ntags: [todo]\n"), &yamlBody))
+	assert.Equal(t, want, Note(yamlBody))
+
+	var textBody CreateNoteTextRequestBody
+	require.NoError(t, runtime.BindBody(newRequest("text/plain", "groceries"), &textBody))
+	assert.Equal(t, CreateNoteTextRequestBody("groceries"), textBody)
+
+	var binaryBody CreateNoteBinaryRequestBody
+	require.NoError(t, runtime.BindBody(newRequest("application/octet-stream", "\x00groceries"), &binaryBody))
+	assert.Equal(t, CreateNoteBinaryRequestBody("\x00groceries"), binaryBody)
+}
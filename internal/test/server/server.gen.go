@@ -4,16 +4,19 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/discord-gophers/goapi-gen/runtime"
 	openapi_types "github.com/discord-gophers/goapi-gen/types"
 	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/render"
 )
 
 // EveryTypeOptional defines model for EveryTypeOptional.
@@ -121,6 +124,9 @@ type UpdateResource3JSONBody struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// PostUploadTextBody defines parameters for PostUpload.
+type PostUploadTextBody string
+
 // CreateResourceJSONRequestBody defines body for CreateResource for application/json ContentType.
 type CreateResourceJSONRequestBody CreateResourceJSONBody
 
@@ -145,6 +151,9 @@ func (UpdateResource3JSONRequestBody) Bind(*http.Request) error {
 	return nil
 }
 
+// PostUploadTextRequestBody defines body for PostUpload for text/plain ContentType.
+type PostUploadTextRequestBody PostUploadTextBody
+
 // Response is a common response struct for all the API calls.
 // A Response object may be instantiated via functions for specific operation responses.
 // It may also be instantiated directly, for the purpose of responding with a single status code.
@@ -152,13 +161,62 @@ type Response struct {
 	body        interface{}
 	Code        int
 	contentType string
+	offers      []string
+	raw         bool
 }
 
-// Render implements the render.Renderer interface. It sets the Content-Type header
-// and status code based on the response definition.
+// Render writes the response. It sets the Content-Type header and status code
+// based on the response definition, and writes the body encoded for its content type.
+// Raw bodies, such as text/plain or image/png, are written as is.
+// Nothing is written if the body can't be encoded.
+//
+// Responses with several representations are written in the one which best
+// matches the Accept header of the request, or in the default content type
+// when none is acceptable.
 func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
-	w.Header().Set("Content-Type", resp.contentType)
-	render.Status(r, resp.Code)
+	contentType := resp.contentType
+	if accept := r.Header.Get("Accept"); accept != "" && len(resp.offers) > 0 {
+		offers := append([]string{contentType}, resp.offers...)
+		if ct := runtime.NegotiateContentType(accept, offers...); ct != "" {
+			contentType = ct
+		}
+	}
+
+	if resp.raw {
+		w.Header().Set("Content-Type", contentType)
+		w.WriteHeader(resp.Code)
+		switch body := resp.body.(type) {
+		case string:
+			io.WriteString(w, body)
+		case []byte:
+			w.Write(body)
+		case io.Reader:
+			if c, ok := body.(io.Closer); ok {
+				defer c.Close()
+			}
+			io.Copy(w, body)
+		}
+		return nil
+	}
+
+	var data []byte
+	var err error
+	switch {
+	case strings.Contains(contentType, "xml"):
+		data, err = xml.Marshal(resp)
+		data = append([]byte(xml.Header), data...)
+	case strings.Contains(contentType, "yaml"):
+		data, err = runtime.MarshalYAML(resp.body)
+	default:
+		data, err = json.Marshal(resp.body)
+	}
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(resp.Code)
+	w.Write(data)
 	return nil
 }
 
@@ -169,6 +227,8 @@ func (resp *Response) Status(code int) *Response {
 }
 
 // ContentType is a builder method to override the default content type for a response.
+// The default content type of a response with several representations is
+// used when the Accept header of the request allows none of them.
 func (resp *Response) ContentType(contentType string) *Response {
 	resp.contentType = contentType
 	return resp
@@ -240,6 +300,50 @@ func GetWithContentTypeJSON200Response(body SomeObject) *Response {
 	}
 }
 
+// GetWithContentTypeTextPlain200Response is a constructor method for a GetWithContentType response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetWithContentTypeTextPlain200Response(body string) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "text/plain",
+		raw:         true,
+	}
+}
+
+// GetReportApplicationPdf200Response is a constructor method for a GetReport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetReportApplicationPdf200Response(body []byte) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/pdf",
+		raw:         true,
+	}
+}
+
+// GetReportImagePng200Response is a constructor method for a GetReport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetReportImagePng200Response(body io.Reader) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "image/png",
+		raw:         true,
+	}
+}
+
+// GetReportTextCsv200Response is a constructor method for a GetReport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetReportTextCsv200Response(body string) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "text/csv",
+		raw:         true,
+	}
+}
+
 // GetReservedKeywordJSON200Response is a constructor method for a GetReservedKeyword response.
 // A *Response is returned with the configured status code and content type from the spec.
 func GetReservedKeywordJSON200Response(body ReservedKeyword) *Response {
@@ -320,6 +424,16 @@ func PostWithTaggedMiddlewareJSON200Response(body struct {
 	}
 }
 
+// defaultBaseURL is the base URL of the server, the path of the URL of the
+// first server of the specification.
+const defaultBaseURL = "/"
+
+// ServerURL0 returns the URL of the server
+// http://example.com.
+func ServerURL0() string {
+	return "http://example.com"
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// get every type optional
@@ -337,6 +451,9 @@ type ServerInterface interface {
 	// Get an object by ID
 	// (GET /get-with-type/{content_type})
 	GetWithContentType(w http.ResponseWriter, r *http.Request, contentType GetWithContentTypeParamsContentType) *Response
+	// Get a report in one of several raw formats
+	// (GET /report/{format})
+	GetReport(w http.ResponseWriter, r *http.Request, format string) *Response
 	// get with reserved keyword
 	// (GET /reserved-keyword)
 	GetReservedKeyword(w http.ResponseWriter, r *http.Request) *Response
@@ -353,6 +470,12 @@ type ServerInterface interface {
 	// get response with reference
 	// (GET /response-with-reference)
 	GetResponseWithReference(w http.ResponseWriter, r *http.Request) *Response
+	// Get something slowly
+	// (GET /slow)
+	GetSlow(w http.ResponseWriter, r *http.Request) *Response
+	// Upload a small body
+	// (POST /upload)
+	PostUpload(w http.ResponseWriter, r *http.Request) *Response
 
 	// (GET /with-tagged-middleware)
 	GetWithTaggedMiddleware(w http.ResponseWriter, r *http.Request) *Response
@@ -366,17 +489,27 @@ type ServerInterfaceWrapper struct {
 	Handler          ServerInterface
 	Middlewares      Middlewares
 	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	Observer         runtime.Observer
 }
 
 // GetEveryTypeOptional operation middleware
 func (siw *ServerInterfaceWrapper) GetEveryTypeOptional(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetEveryTypeOptional", "/every-type-optional")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetEveryTypeOptional(w, r)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -390,11 +523,20 @@ func (siw *ServerInterfaceWrapper) GetEveryTypeOptional(w http.ResponseWriter, r
 func (siw *ServerInterfaceWrapper) GetSimple(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetSimple", "/get-simple")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetSimple(w, r)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -408,23 +550,51 @@ func (siw *ServerInterfaceWrapper) GetSimple(w http.ResponseWriter, r *http.Requ
 func (siw *ServerInterfaceWrapper) GetWithArgs(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetWithArgs", "/get-with-args")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetWithArgsParams
 
+	query := r.URL.Query()
+
 	// ------------- Optional query parameter "optional_argument" -------------
 
-	if err := runtime.BindQueryParameter("form", true, false, "optional_argument", r.URL.Query(), &params.OptionalArgument); err != nil {
+	if raw, found, err := runtime.QueryParameterValue("form", true, "optional_argument", query); err != nil {
 		err = fmt.Errorf("invalid format for parameter optional_argument: %w", err)
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "optional_argument"})
 		return
+	} else if found {
+		if value, err := runtime.ParseInt64(raw); err != nil {
+			err = fmt.Errorf("invalid format for parameter optional_argument: %w", err)
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "optional_argument"})
+			return
+		} else {
+			params.OptionalArgument = &value
+		}
 	}
 
 	// ------------- Required query parameter "required_argument" -------------
 
-	if err := runtime.BindQueryParameter("form", true, true, "required_argument", r.URL.Query(), &params.RequiredArgument); err != nil {
+	if raw, found, err := runtime.QueryParameterValue("form", true, "required_argument", query); err != nil {
 		err = fmt.Errorf("invalid format for parameter required_argument: %w", err)
 		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "required_argument"})
 		return
+	} else if found {
+		if value, err := runtime.ParseInt64(raw); err != nil {
+			err = fmt.Errorf("invalid format for parameter required_argument: %w", err)
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "required_argument"})
+			return
+		} else {
+			params.RequiredArgument = value
+		}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{paramName: "required_argument"})
+		return
 	}
 
 	headers := r.Header
@@ -438,7 +608,10 @@ func (siw *ServerInterfaceWrapper) GetWithArgs(w http.ResponseWriter, r *http.Re
 			return
 		}
 
-		if err := runtime.BindStyledParameterWithLocation("simple", false, "header_argument", runtime.ParamLocationHeader, valueList[0], &HeaderArgument); err != nil {
+		if raw, err := runtime.StyledParameterValueWithLocation("simple", false, "header_argument", runtime.ParamLocationHeader, valueList[0]); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "header_argument"})
+			return
+		} else if HeaderArgument, err = runtime.ParseInt32(raw); err != nil {
 			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "header_argument"})
 			return
 		}
@@ -450,8 +623,10 @@ func (siw *ServerInterfaceWrapper) GetWithArgs(w http.ResponseWriter, r *http.Re
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetWithArgs(w, r, params)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -465,10 +640,20 @@ func (siw *ServerInterfaceWrapper) GetWithArgs(w http.ResponseWriter, r *http.Re
 func (siw *ServerInterfaceWrapper) GetWithReferences(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetWithReferences", "/get-with-references/{global_argument}/{argument}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "global_argument" -------------
 	var globalArgument int64
 
-	if err := runtime.BindStyledParameter("simple", false, "global_argument", chi.URLParam(r, "global_argument"), &globalArgument); err != nil {
+	if raw, err := runtime.StyledParameterValue("simple", false, "global_argument", chi.URLParam(r, "global_argument")); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "global_argument"})
+		return
+	} else if globalArgument, err = runtime.ParseInt64(raw); err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "global_argument"})
 		return
 	}
@@ -484,8 +669,10 @@ func (siw *ServerInterfaceWrapper) GetWithReferences(w http.ResponseWriter, r *h
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetWithReferences(w, r, globalArgument, argument)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -499,6 +686,13 @@ func (siw *ServerInterfaceWrapper) GetWithReferences(w http.ResponseWriter, r *h
 func (siw *ServerInterfaceWrapper) GetWithContentType(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetWithContentType", "/get-with-type/{content_type}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "content_type" -------------
 	var contentType GetWithContentTypeParamsContentType
 
@@ -510,8 +704,47 @@ func (siw *ServerInterfaceWrapper) GetWithContentType(w http.ResponseWriter, r *
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetWithContentType(w, r, contentType)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetReport operation middleware
+func (siw *ServerInterfaceWrapper) GetReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetReport", "/report/{format}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
+	// ------------- Path parameter "format" -------------
+	var format string
+
+	if raw, err := runtime.StyledParameterValue("simple", false, "format", chi.URLParam(r, "format")); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "format"})
+		return
+	} else {
+		format = raw
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetReport(w, r, format)
+		if resp != nil {
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -525,11 +758,20 @@ func (siw *ServerInterfaceWrapper) GetWithContentType(w http.ResponseWriter, r *
 func (siw *ServerInterfaceWrapper) GetReservedKeyword(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetReservedKeyword", "/reserved-keyword")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetReservedKeyword(w, r)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -543,6 +785,13 @@ func (siw *ServerInterfaceWrapper) GetReservedKeyword(w http.ResponseWriter, r *
 func (siw *ServerInterfaceWrapper) CreateResource(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "CreateResource", "/resource/{argument}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "argument" -------------
 	var argument Argument
 
@@ -554,8 +803,10 @@ func (siw *ServerInterfaceWrapper) CreateResource(w http.ResponseWriter, r *http
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.CreateResource(w, r, argument)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -569,10 +820,20 @@ func (siw *ServerInterfaceWrapper) CreateResource(w http.ResponseWriter, r *http
 func (siw *ServerInterfaceWrapper) CreateResource2(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "CreateResource2", "/resource2/{inline_argument}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "inline_argument" -------------
 	var inlineArgument int
 
-	if err := runtime.BindStyledParameter("simple", false, "inline_argument", chi.URLParam(r, "inline_argument"), &inlineArgument); err != nil {
+	if raw, err := runtime.StyledParameterValue("simple", false, "inline_argument", chi.URLParam(r, "inline_argument")); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "inline_argument"})
+		return
+	} else if inlineArgument, err = runtime.ParseInt(raw); err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "inline_argument"})
 		return
 	}
@@ -580,19 +841,31 @@ func (siw *ServerInterfaceWrapper) CreateResource2(w http.ResponseWriter, r *htt
 	// Parameter object where we will unmarshal all parameters from the context
 	var params CreateResource2Params
 
+	query := r.URL.Query()
+
 	// ------------- Optional query parameter "inline_query_argument" -------------
 
-	if err := runtime.BindQueryParameter("form", true, false, "inline_query_argument", r.URL.Query(), &params.InlineQueryArgument); err != nil {
+	if raw, found, err := runtime.QueryParameterValue("form", true, "inline_query_argument", query); err != nil {
 		err = fmt.Errorf("invalid format for parameter inline_query_argument: %w", err)
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "inline_query_argument"})
 		return
+	} else if found {
+		if value, err := runtime.ParseInt(raw); err != nil {
+			err = fmt.Errorf("invalid format for parameter inline_query_argument: %w", err)
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "inline_query_argument"})
+			return
+		} else {
+			params.InlineQueryArgument = &value
+		}
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.CreateResource2(w, r, inlineArgument, params)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -606,10 +879,20 @@ func (siw *ServerInterfaceWrapper) CreateResource2(w http.ResponseWriter, r *htt
 func (siw *ServerInterfaceWrapper) UpdateResource3(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "UpdateResource3", "/resource3/{fallthrough}")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	// ------------- Path parameter "fallthrough" -------------
 	var pFallthrough int
 
-	if err := runtime.BindStyledParameter("simple", false, "fallthrough", chi.URLParam(r, "fallthrough"), &pFallthrough); err != nil {
+	if raw, err := runtime.StyledParameterValue("simple", false, "fallthrough", chi.URLParam(r, "fallthrough")); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "fallthrough"})
+		return
+	} else if pFallthrough, err = runtime.ParseInt(raw); err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "fallthrough"})
 		return
 	}
@@ -617,8 +900,10 @@ func (siw *ServerInterfaceWrapper) UpdateResource3(w http.ResponseWriter, r *htt
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.UpdateResource3(w, r, pFallthrough)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -632,11 +917,96 @@ func (siw *ServerInterfaceWrapper) UpdateResource3(w http.ResponseWriter, r *htt
 func (siw *ServerInterfaceWrapper) GetResponseWithReference(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetResponseWithReference", "/response-with-reference")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetResponseWithReference(w, r)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetSlow operation middleware
+func (siw *ServerInterfaceWrapper) GetSlow(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetSlow", "/slow")
+		defer call.End()
+		ctx = r.Context()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetSlow(w, r)
+		if resp != nil {
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Only the deadline of the operation is reported, the request may also
+	// have been canceled.
+	if !runtime.ServeWithTimeout(handler, w, r.WithContext(ctx)) && r.Context().Err() == nil {
+		siw.ErrorHandlerFunc(w, r, &TimeoutError{Timeout: 50 * time.Millisecond})
+	}
+}
+
+// PostUpload operation middleware
+func (siw *ServerInterfaceWrapper) PostUpload(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "PostUpload", "/upload")
+		defer call.End()
+		ctx = r.Context()
+	}
+
+	if r.ContentLength > 8 {
+		siw.ErrorHandlerFunc(w, r, &RequestBodyTooLargeError{Limit: 8})
+		return
+	}
+	body := runtime.NewMaxBytesBody(w, r, 8)
+	r.Body = body
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The handler may have responded to the failed read of the body
+		// itself.
+		rec := runtime.NewStatusRecorder(w)
+		w = rec
+		resp := siw.Handler.PostUpload(w, r)
+		if body.Exceeded() && !rec.Written() {
+			siw.ErrorHandlerFunc(w, r, &RequestBodyTooLargeError{Limit: 8})
+			return
+		}
+		if resp != nil {
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -650,11 +1020,20 @@ func (siw *ServerInterfaceWrapper) GetResponseWithReference(w http.ResponseWrite
 func (siw *ServerInterfaceWrapper) GetWithTaggedMiddleware(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetWithTaggedMiddleware", "/with-tagged-middleware")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.GetWithTaggedMiddleware(w, r)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -671,11 +1050,20 @@ func (siw *ServerInterfaceWrapper) GetWithTaggedMiddleware(w http.ResponseWriter
 func (siw *ServerInterfaceWrapper) PostWithTaggedMiddleware(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "PostWithTaggedMiddleware", "/with-tagged-middleware")
+		defer call.End()
+		ctx = r.Context()
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := siw.Handler.PostWithTaggedMiddleware(w, r)
 		if resp != nil {
-			if resp.body != nil {
-				render.Render(w, r, resp)
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
 			} else {
 				w.WriteHeader(resp.Code)
 			}
@@ -760,6 +1148,28 @@ func (err TooManyValuesForParamError) Error() string {
 	return fmt.Sprintf("expected one value for %s, got %d", err.paramName, err.NumValues)
 }
 
+// TimeoutError is passed to the ErrorHandlerFunc when the handler of an
+// operation doesn't return within its x-go-timeout.
+type TimeoutError struct {
+	Timeout time.Duration
+}
+
+// Error implements error.
+func (err TimeoutError) Error() string {
+	return fmt.Sprintf("request timed out after %v", err.Timeout)
+}
+
+// RequestBodyTooLargeError is passed to the ErrorHandlerFunc when the request
+// body is larger than the x-go-max-body-bytes of an operation.
+type RequestBodyTooLargeError struct {
+	Limit int64
+}
+
+// Error implements error.
+func (err RequestBodyTooLargeError) Error() string {
+	return fmt.Sprintf("request body is larger than %d bytes", err.Limit)
+}
+
 // ParameterName is an interface that is implemented by error types that are
 // relevant to a specific parameter.
 type ParameterError interface {
@@ -786,18 +1196,39 @@ type ServerOptions struct {
 	BaseRouter       chi.Router
 	Middlewares      Middlewares
 	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	Observer         runtime.Observer
 }
 
 type ServerOption func(*ServerOptions)
 
 // Handler creates http.Handler with routing matching OpenAPI spec.
+// It panics if the options are invalid, such as when a tagged middleware is missing.
 func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
+	h, err := NewHandler(si, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// NewHandler creates http.Handler with routing matching OpenAPI spec.
+// An error is returned if the options are invalid, such as when a tagged middleware is missing.
+func NewHandler(si ServerInterface, opts ...ServerOption) (http.Handler, error) {
 	options := &ServerOptions{
-		BaseURL:     "/",
+		BaseURL:     defaultBaseURL,
 		BaseRouter:  chi.NewRouter(),
 		Middlewares: Middlewares{},
 		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			status := http.StatusBadRequest
+			var timeoutErr *TimeoutError
+			if errors.As(err, &timeoutErr) {
+				status = http.StatusServiceUnavailable
+			}
+			var tooLargeErr *RequestBodyTooLargeError
+			if errors.As(err, &tooLargeErr) {
+				status = http.StatusRequestEntityTooLarge
+			}
+			http.Error(w, err.Error(), status)
 		},
 	}
 
@@ -810,14 +1241,22 @@ func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
 		Handler:          si,
 		Middlewares:      options.Middlewares,
 		ErrorHandlerFunc: options.ErrorHandlerFunc,
+		Observer:         options.Observer,
+	}
+
+	// Errors, such as parameter binding errors, are recorded on the observed
+	// call of the operation.
+	wrapper.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+		runtime.SetCallError(r.Context(), err)
+		options.ErrorHandlerFunc(w, r, err)
 	}
 
 	// Operation specific middleware
 	if options.Middlewares.Operation == nil {
-		panic("goapi-gen: could not find tagged middleware operation (Operation)")
+		return nil, errors.New("goapi-gen: could not find tagged middleware operation (Operation)")
 	}
 	if options.Middlewares.Path == nil {
-		panic("goapi-gen: could not find tagged middleware path (Path)")
+		return nil, errors.New("goapi-gen: could not find tagged middleware path (Path)")
 	}
 
 	r.Route(options.BaseURL, func(r chi.Router) {
@@ -826,15 +1265,18 @@ func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
 		r.Get("/get-with-args", wrapper.GetWithArgs)
 		r.Get("/get-with-references/{global_argument}/{argument}", wrapper.GetWithReferences)
 		r.Get("/get-with-type/{content_type}", wrapper.GetWithContentType)
+		r.Get("/report/{format}", wrapper.GetReport)
 		r.Get("/reserved-keyword", wrapper.GetReservedKeyword)
 		r.Post("/resource/{argument}", wrapper.CreateResource)
 		r.Post("/resource2/{inline_argument}", wrapper.CreateResource2)
 		r.Put("/resource3/{fallthrough}", wrapper.UpdateResource3)
 		r.Get("/response-with-reference", wrapper.GetResponseWithReference)
+		r.Get("/slow", wrapper.GetSlow)
+		r.Post("/upload", wrapper.PostUpload)
 		r.Get("/with-tagged-middleware", wrapper.GetWithTaggedMiddleware)
 		r.Post("/with-tagged-middleware", wrapper.PostWithTaggedMiddleware)
 	})
-	return r
+	return r, nil
 }
 
 func WithRouter(r chi.Router) ServerOption {
@@ -872,3 +1314,12 @@ func WithErrorHandler(handler func(w http.ResponseWriter, r *http.Request, err e
 		s.ErrorHandlerFunc = handler
 	}
 }
+
+// WithObserver reports each call of an operation to o once it returns, with
+// its operation ID, method, route template, status code, response size,
+// duration and the error passed to the error handler, if any.
+func WithObserver(o runtime.Observer) ServerOption {
+	return func(s *ServerOptions) {
+		s.Observer = o
+	}
+}
@@ -27,6 +27,9 @@ var _ ServerInterface = &ServerInterfaceMock{}
 //			GetEveryTypeOptionalFunc: func(w http.ResponseWriter, r *http.Request) *Response {
 //				panic("mock out the GetEveryTypeOptional method")
 //			},
+//			GetReportFunc: func(w http.ResponseWriter, r *http.Request, format string) *Response {
+//				panic("mock out the GetReport method")
+//			},
 //			GetReservedKeywordFunc: func(w http.ResponseWriter, r *http.Request) *Response {
 //				panic("mock out the GetReservedKeyword method")
 //			},
@@ -36,6 +39,9 @@ var _ ServerInterface = &ServerInterfaceMock{}
 //			GetSimpleFunc: func(w http.ResponseWriter, r *http.Request) *Response {
 //				panic("mock out the GetSimple method")
 //			},
+//			GetSlowFunc: func(w http.ResponseWriter, r *http.Request) *Response {
+//				panic("mock out the GetSlow method")
+//			},
 //			GetWithArgsFunc: func(w http.ResponseWriter, r *http.Request, params GetWithArgsParams) *Response {
 //				panic("mock out the GetWithArgs method")
 //			},
@@ -48,6 +54,9 @@ var _ ServerInterface = &ServerInterfaceMock{}
 //			GetWithTaggedMiddlewareFunc: func(w http.ResponseWriter, r *http.Request) *Response {
 //				panic("mock out the GetWithTaggedMiddleware method")
 //			},
+//			PostUploadFunc: func(w http.ResponseWriter, r *http.Request) *Response {
+//				panic("mock out the PostUpload method")
+//			},
 //			PostWithTaggedMiddlewareFunc: func(w http.ResponseWriter, r *http.Request) *Response {
 //				panic("mock out the PostWithTaggedMiddleware method")
 //			},
@@ -70,6 +79,9 @@ type ServerInterfaceMock struct {
 	// GetEveryTypeOptionalFunc mocks the GetEveryTypeOptional method.
 	GetEveryTypeOptionalFunc func(w http.ResponseWriter, r *http.Request) *Response
 
+	// GetReportFunc mocks the GetReport method.
+	GetReportFunc func(w http.ResponseWriter, r *http.Request, format string) *Response
+
 	// GetReservedKeywordFunc mocks the GetReservedKeyword method.
 	GetReservedKeywordFunc func(w http.ResponseWriter, r *http.Request) *Response
 
@@ -79,6 +91,9 @@ type ServerInterfaceMock struct {
 	// GetSimpleFunc mocks the GetSimple method.
 	GetSimpleFunc func(w http.ResponseWriter, r *http.Request) *Response
 
+	// GetSlowFunc mocks the GetSlow method.
+	GetSlowFunc func(w http.ResponseWriter, r *http.Request) *Response
+
 	// GetWithArgsFunc mocks the GetWithArgs method.
 	GetWithArgsFunc func(w http.ResponseWriter, r *http.Request, params GetWithArgsParams) *Response
 
@@ -91,6 +106,9 @@ type ServerInterfaceMock struct {
 	// GetWithTaggedMiddlewareFunc mocks the GetWithTaggedMiddleware method.
 	GetWithTaggedMiddlewareFunc func(w http.ResponseWriter, r *http.Request) *Response
 
+	// PostUploadFunc mocks the PostUpload method.
+	PostUploadFunc func(w http.ResponseWriter, r *http.Request) *Response
+
 	// PostWithTaggedMiddlewareFunc mocks the PostWithTaggedMiddleware method.
 	PostWithTaggedMiddlewareFunc func(w http.ResponseWriter, r *http.Request) *Response
 
@@ -126,6 +144,15 @@ type ServerInterfaceMock struct {
 			// R is the r argument value.
 			R *http.Request
 		}
+		// GetReport holds details about calls to the GetReport method.
+		GetReport []struct {
+			// W is the w argument value.
+			W http.ResponseWriter
+			// R is the r argument value.
+			R *http.Request
+			// Format is the format argument value.
+			Format string
+		}
 		// GetReservedKeyword holds details about calls to the GetReservedKeyword method.
 		GetReservedKeyword []struct {
 			// W is the w argument value.
@@ -147,6 +174,13 @@ type ServerInterfaceMock struct {
 			// R is the r argument value.
 			R *http.Request
 		}
+		// GetSlow holds details about calls to the GetSlow method.
+		GetSlow []struct {
+			// W is the w argument value.
+			W http.ResponseWriter
+			// R is the r argument value.
+			R *http.Request
+		}
 		// GetWithArgs holds details about calls to the GetWithArgs method.
 		GetWithArgs []struct {
 			// W is the w argument value.
@@ -183,6 +217,13 @@ type ServerInterfaceMock struct {
 			// R is the r argument value.
 			R *http.Request
 		}
+		// PostUpload holds details about calls to the PostUpload method.
+		PostUpload []struct {
+			// W is the w argument value.
+			W http.ResponseWriter
+			// R is the r argument value.
+			R *http.Request
+		}
 		// PostWithTaggedMiddleware holds details about calls to the PostWithTaggedMiddleware method.
 		PostWithTaggedMiddleware []struct {
 			// W is the w argument value.
@@ -203,13 +244,16 @@ type ServerInterfaceMock struct {
 	lockCreateResource           sync.RWMutex
 	lockCreateResource2          sync.RWMutex
 	lockGetEveryTypeOptional     sync.RWMutex
+	lockGetReport                sync.RWMutex
 	lockGetReservedKeyword       sync.RWMutex
 	lockGetResponseWithReference sync.RWMutex
 	lockGetSimple                sync.RWMutex
+	lockGetSlow                  sync.RWMutex
 	lockGetWithArgs              sync.RWMutex
 	lockGetWithContentType       sync.RWMutex
 	lockGetWithReferences        sync.RWMutex
 	lockGetWithTaggedMiddleware  sync.RWMutex
+	lockPostUpload               sync.RWMutex
 	lockPostWithTaggedMiddleware sync.RWMutex
 	lockUpdateResource3          sync.RWMutex
 }
@@ -334,6 +378,46 @@ func (mock *ServerInterfaceMock) GetEveryTypeOptionalCalls() []struct {
 	return calls
 }
 
+// GetReport calls GetReportFunc.
+func (mock *ServerInterfaceMock) GetReport(w http.ResponseWriter, r *http.Request, format string) *Response {
+	if mock.GetReportFunc == nil {
+		panic("ServerInterfaceMock.GetReportFunc: method is nil but ServerInterface.GetReport was just called")
+	}
+	callInfo := struct {
+		W      http.ResponseWriter
+		R      *http.Request
+		Format string
+	}{
+		W:      w,
+		R:      r,
+		Format: format,
+	}
+	mock.lockGetReport.Lock()
+	mock.calls.GetReport = append(mock.calls.GetReport, callInfo)
+	mock.lockGetReport.Unlock()
+	return mock.GetReportFunc(w, r, format)
+}
+
+// GetReportCalls gets all the calls that were made to GetReport.
+// Check the length with:
+//
+//	len(mockedServerInterface.GetReportCalls())
+func (mock *ServerInterfaceMock) GetReportCalls() []struct {
+	W      http.ResponseWriter
+	R      *http.Request
+	Format string
+} {
+	var calls []struct {
+		W      http.ResponseWriter
+		R      *http.Request
+		Format string
+	}
+	mock.lockGetReport.RLock()
+	calls = mock.calls.GetReport
+	mock.lockGetReport.RUnlock()
+	return calls
+}
+
 // GetReservedKeyword calls GetReservedKeywordFunc.
 func (mock *ServerInterfaceMock) GetReservedKeyword(w http.ResponseWriter, r *http.Request) *Response {
 	if mock.GetReservedKeywordFunc == nil {
@@ -442,6 +526,42 @@ func (mock *ServerInterfaceMock) GetSimpleCalls() []struct {
 	return calls
 }
 
+// GetSlow calls GetSlowFunc.
+func (mock *ServerInterfaceMock) GetSlow(w http.ResponseWriter, r *http.Request) *Response {
+	if mock.GetSlowFunc == nil {
+		panic("ServerInterfaceMock.GetSlowFunc: method is nil but ServerInterface.GetSlow was just called")
+	}
+	callInfo := struct {
+		W http.ResponseWriter
+		R *http.Request
+	}{
+		W: w,
+		R: r,
+	}
+	mock.lockGetSlow.Lock()
+	mock.calls.GetSlow = append(mock.calls.GetSlow, callInfo)
+	mock.lockGetSlow.Unlock()
+	return mock.GetSlowFunc(w, r)
+}
+
+// GetSlowCalls gets all the calls that were made to GetSlow.
+// Check the length with:
+//
+//	len(mockedServerInterface.GetSlowCalls())
+func (mock *ServerInterfaceMock) GetSlowCalls() []struct {
+	W http.ResponseWriter
+	R *http.Request
+} {
+	var calls []struct {
+		W http.ResponseWriter
+		R *http.Request
+	}
+	mock.lockGetSlow.RLock()
+	calls = mock.calls.GetSlow
+	mock.lockGetSlow.RUnlock()
+	return calls
+}
+
 // GetWithArgs calls GetWithArgsFunc.
 func (mock *ServerInterfaceMock) GetWithArgs(w http.ResponseWriter, r *http.Request, params GetWithArgsParams) *Response {
 	if mock.GetWithArgsFunc == nil {
@@ -602,6 +722,42 @@ func (mock *ServerInterfaceMock) GetWithTaggedMiddlewareCalls() []struct {
 	return calls
 }
 
+// PostUpload calls PostUploadFunc.
+func (mock *ServerInterfaceMock) PostUpload(w http.ResponseWriter, r *http.Request) *Response {
+	if mock.PostUploadFunc == nil {
+		panic("ServerInterfaceMock.PostUploadFunc: method is nil but ServerInterface.PostUpload was just called")
+	}
+	callInfo := struct {
+		W http.ResponseWriter
+		R *http.Request
+	}{
+		W: w,
+		R: r,
+	}
+	mock.lockPostUpload.Lock()
+	mock.calls.PostUpload = append(mock.calls.PostUpload, callInfo)
+	mock.lockPostUpload.Unlock()
+	return mock.PostUploadFunc(w, r)
+}
+
+// PostUploadCalls gets all the calls that were made to PostUpload.
+// Check the length with:
+//
+//	len(mockedServerInterface.PostUploadCalls())
+func (mock *ServerInterfaceMock) PostUploadCalls() []struct {
+	W http.ResponseWriter
+	R *http.Request
+} {
+	var calls []struct {
+		W http.ResponseWriter
+		R *http.Request
+	}
+	mock.lockPostUpload.RLock()
+	calls = mock.calls.PostUpload
+	mock.lockPostUpload.RUnlock()
+	return calls
+}
+
 // PostWithTaggedMiddleware calls PostWithTaggedMiddlewareFunc.
 func (mock *ServerInterfaceMock) PostWithTaggedMiddleware(w http.ResponseWriter, r *http.Request) *Response {
 	if mock.PostWithTaggedMiddlewareFunc == nil {
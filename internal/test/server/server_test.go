@@ -1,12 +1,18 @@
 package server
 
 import (
+	"context"
 	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/discord-gophers/goapi-gen/runtime"
 )
 
 // Define the required middleware. If these are not defined, the handler
@@ -64,6 +70,17 @@ func TestOmitMiddlewares(t *testing.T) {
 	t.Error("Expected panic without providing middlewares")
 }
 
+func TestNewHandlerMissingMiddlewares(t *testing.T) {
+	m := ServerInterfaceMock{}
+
+	_, err := NewHandler(&m, WithPathMiddleware(func(h http.Handler) http.Handler { return h }))
+	assert.EqualError(t, err, "goapi-gen: could not find tagged middleware operation (Operation)")
+
+	h, err := NewHandler(&m, WithMiddlewares(noopMiddlewares))
+	assert.NoError(t, err)
+	assert.NotNil(t, h)
+}
+
 func TestMiddlewareCalled(t *testing.T) {
 	m := ServerInterfaceMock{}
 	m.GetWithTaggedMiddlewareFunc = func(w http.ResponseWriter, r *http.Request) *Response { return nil }
@@ -114,3 +131,209 @@ func TestMiddlewareCalledWithOrder(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, []string{"first", "second"}, order)
 }
+
+func TestRawResponses(t *testing.T) {
+	m := ServerInterfaceMock{}
+	m.GetReportFunc = func(w http.ResponseWriter, r *http.Request, format string) *Response {
+		switch format {
+		case "csv":
+			return GetReportTextCsv200Response("id,name\n1,report\n")
+		case "png":
+			return GetReportImagePng200Response(strings.NewReader("\x89PNG"))
+		case "pdf":
+			return GetReportApplicationPdf200Response([]byte("%PDF-1.7"))
+		}
+		return &Response{Code: http.StatusNotFound}
+	}
+
+	h := Handler(&m, WithMiddlewares(noopMiddlewares))
+
+	tests := []struct {
+		format      string
+		contentType string
+		body        string
+	}{
+		{format: "csv", contentType: "text/csv", body: "id,name\n1,report\n"},
+		{format: "png", contentType: "image/png", body: "\x89PNG"},
+		{format: "pdf", contentType: "application/pdf", body: "%PDF-1.7"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, httptest.NewRequest("GET", "/report/"+tt.format, nil))
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, tt.contentType, rr.Header().Get("Content-Type"))
+			assert.Equal(t, tt.body, rr.Body.String())
+		})
+	}
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest("GET", "/report/doc", nil))
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestTimeout(t *testing.T) {
+	m := ServerInterfaceMock{}
+	m.GetSlowFunc = func(w http.ResponseWriter, r *http.Request) *Response {
+		deadline, ok := r.Context().Deadline()
+		assert.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(50*time.Millisecond), deadline, 50*time.Millisecond)
+
+		if r.URL.Query().Get("wait") == "" {
+			return &Response{Code: http.StatusOK}
+		}
+		<-r.Context().Done()
+		return &Response{Code: http.StatusOK}
+	}
+
+	h := Handler(&m, WithMiddlewares(noopMiddlewares))
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest("GET", "/slow", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	rr = httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest("GET", "/slow?wait=1", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	assert.Equal(t, "request timed out after 50ms\n", rr.Body.String())
+
+	var timeoutErr *TimeoutError
+	h = Handler(&m, WithMiddlewares(noopMiddlewares), WithErrorHandler(func(w http.ResponseWriter, r *http.Request, err error) {
+		assert.True(t, errors.As(err, &timeoutErr))
+		w.WriteHeader(http.StatusGatewayTimeout)
+	}))
+	rr = httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest("GET", "/slow?wait=1", nil))
+	assert.Equal(t, http.StatusGatewayTimeout, rr.Code)
+	assert.Equal(t, 50*time.Millisecond, timeoutErr.Timeout)
+}
+
+func TestTimeoutIgnoredByHandler(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	m := ServerInterfaceMock{}
+	m.GetSlowFunc = func(w http.ResponseWriter, r *http.Request) *Response {
+		w.WriteHeader(http.StatusAccepted)
+		<-release
+		return &Response{Code: http.StatusOK}
+	}
+
+	h := Handler(&m, WithMiddlewares(noopMiddlewares))
+	start := time.Now()
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest("GET", "/slow", nil))
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	assert.Equal(t, "request timed out after 50ms\n", rr.Body.String())
+}
+
+func TestTimeoutOfParentContext(t *testing.T) {
+	m := ServerInterfaceMock{}
+	m.GetSlowFunc = func(w http.ResponseWriter, r *http.Request) *Response {
+		<-r.Context().Done()
+		return &Response{Code: http.StatusOK}
+	}
+
+	var errs []error
+	h := Handler(&m, WithMiddlewares(noopMiddlewares), WithErrorHandler(func(w http.ResponseWriter, r *http.Request, err error) {
+		errs = append(errs, err)
+	}))
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest("GET", "/slow", nil).WithContext(ctx))
+	assert.Empty(t, errs)
+	assert.False(t, rr.Flushed)
+	assert.Empty(t, rr.Body.String())
+}
+
+func TestMaxBodyBytes(t *testing.T) {
+	m := ServerInterfaceMock{}
+	m.PostUploadFunc = func(w http.ResponseWriter, r *http.Request) *Response {
+		var body PostUploadTextRequestBody
+		if err := runtime.BindBody(r, &body); err != nil {
+			return &Response{Code: http.StatusBadRequest}
+		}
+		return &Response{Code: http.StatusNoContent}
+	}
+
+	h := Handler(&m, WithMiddlewares(noopMiddlewares))
+
+	tests := []struct {
+		name          string
+		body          io.Reader
+		contentLength int64
+		code          int
+	}{
+		{name: "small", body: strings.NewReader("12345678"), code: http.StatusNoContent},
+		{name: "large", body: strings.NewReader("123456789"), code: http.StatusRequestEntityTooLarge},
+		{name: "chunked", body: io.MultiReader(strings.NewReader("12345"), strings.NewReader("6789")), contentLength: -1, code: http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/upload", tt.body)
+			r.Header.Set("Content-Type", "text/plain")
+			if tt.contentLength != 0 {
+				r.ContentLength = tt.contentLength
+			}
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, r)
+			assert.Equal(t, tt.code, rr.Code)
+		})
+	}
+	assert.Len(t, m.PostUploadCalls(), 2)
+
+	// Handlers may respond to a body which is too large themselves.
+	m.PostUploadFunc = func(w http.ResponseWriter, r *http.Request) *Response {
+		if _, err := io.ReadAll(r.Body); err != nil {
+			http.Error(w, "too much", http.StatusBadRequest)
+		}
+		return nil
+	}
+	r := httptest.NewRequest("POST", "/upload", io.MultiReader(strings.NewReader("12345"), strings.NewReader("6789")))
+	r.Header.Set("Content-Type", "text/plain")
+	r.ContentLength = -1
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, r)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Equal(t, "too much\n", rr.Body.String())
+}
+
+func TestObserver(t *testing.T) {
+	m := ServerInterfaceMock{}
+	m.GetReportFunc = func(w http.ResponseWriter, r *http.Request, format string) *Response {
+		return GetReportTextCsv200Response("id,name\n1,report\n")
+	}
+
+	var observations []runtime.Observation
+	h := Handler(&m, WithMiddlewares(noopMiddlewares), WithObserver(runtime.ObserverFunc(func(o runtime.Observation) {
+		observations = append(observations, o)
+	})))
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest("GET", "/report/csv", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	rr = httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest("GET", "/get-with-args", nil))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	if assert.Len(t, observations, 2) {
+		o := observations[0]
+		assert.Equal(t, "GetReport", o.OperationID)
+		assert.Equal(t, "GET", o.Method)
+		assert.Equal(t, "/report/{format}", o.Route)
+		assert.Equal(t, http.StatusOK, o.Status)
+		assert.Equal(t, int64(len("id,name\n1,report\n")), o.Size)
+		assert.NoError(t, o.Err)
+
+		o = observations[1]
+		assert.Equal(t, "GetWithArgs", o.OperationID)
+		assert.Equal(t, "/get-with-args", o.Route)
+		assert.Equal(t, http.StatusBadRequest, o.Status)
+		var paramErr *RequiredParamError
+		assert.True(t, errors.As(o.Err, &paramErr))
+		assert.Equal(t, "required_argument", paramErr.ParamName())
+	}
+}
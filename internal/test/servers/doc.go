@@ -0,0 +1,3 @@
+package servers
+
+//go:generate go run github.com/discord-gophers/goapi-gen --generate=types,server --package=servers -o servers.gen.go servers.yaml
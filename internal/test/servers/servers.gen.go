@@ -0,0 +1,424 @@
+// Package servers provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/discord-gophers/goapi-gen version (devel) DO NOT EDIT.
+package servers
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/discord-gophers/goapi-gen/runtime"
+	"github.com/go-chi/chi/v5"
+)
+
+// Response is a common response struct for all the API calls.
+// A Response object may be instantiated via functions for specific operation responses.
+// It may also be instantiated directly, for the purpose of responding with a single status code.
+type Response struct {
+	body        interface{}
+	Code        int
+	contentType string
+	offers      []string
+	raw         bool
+}
+
+// Render writes the response. It sets the Content-Type header and status code
+// based on the response definition, and writes the body encoded for its content type.
+// Raw bodies, such as text/plain or image/png, are written as is.
+// Nothing is written if the body can't be encoded.
+//
+// Responses with several representations are written in the one which best
+// matches the Accept header of the request, or in the default content type
+// when none is acceptable.
+func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
+	contentType := resp.contentType
+	if accept := r.Header.Get("Accept"); accept != "" && len(resp.offers) > 0 {
+		offers := append([]string{contentType}, resp.offers...)
+		if ct := runtime.NegotiateContentType(accept, offers...); ct != "" {
+			contentType = ct
+		}
+	}
+
+	if resp.raw {
+		w.Header().Set("Content-Type", contentType)
+		w.WriteHeader(resp.Code)
+		switch body := resp.body.(type) {
+		case string:
+			io.WriteString(w, body)
+		case []byte:
+			w.Write(body)
+		case io.Reader:
+			if c, ok := body.(io.Closer); ok {
+				defer c.Close()
+			}
+			io.Copy(w, body)
+		}
+		return nil
+	}
+
+	var data []byte
+	var err error
+	switch {
+	case strings.Contains(contentType, "xml"):
+		data, err = xml.Marshal(resp)
+		data = append([]byte(xml.Header), data...)
+	case strings.Contains(contentType, "yaml"):
+		data, err = runtime.MarshalYAML(resp.body)
+	default:
+		data, err = json.Marshal(resp.body)
+	}
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(resp.Code)
+	w.Write(data)
+	return nil
+}
+
+// Status is a builder method to override the default status code for a response.
+func (resp *Response) Status(code int) *Response {
+	resp.Code = code
+	return resp
+}
+
+// ContentType is a builder method to override the default content type for a response.
+// The default content type of a response with several representations is
+// used when the Accept header of the request allows none of them.
+func (resp *Response) ContentType(contentType string) *Response {
+	resp.contentType = contentType
+	return resp
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// This is used to only marshal the body of the response.
+func (resp *Response) MarshalJSON() ([]byte, error) {
+	return json.Marshal(resp.body)
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+// This is used to only marshal the body of the response.
+func (resp *Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.Encode(resp.body)
+}
+
+// defaultBaseURL is the base URL of the server, the path of the URL of the
+// first server of the specification.
+const defaultBaseURL = "/v1"
+
+// ServerURLProduction returns the URL of the server
+// https://{env}.example.com:{port}/v1, with the default values of its variables.
+//
+// Production
+func ServerURLProduction() string {
+	return "https://api.example.com:443/v1"
+}
+
+// ServerVariablesProduction are the variables of the URL of the server
+// https://{env}.example.com:{port}/v1. Empty variables have their default value.
+type ServerVariablesProduction struct {
+	// Environment of the server.
+	Env  string // {env}, defaults to "api", one of "api", "staging"
+	Port string // {port}, defaults to "443"
+}
+
+// ServerURL returns the URL of the server with vars. An error is returned if
+// a variable isn't one of its enum values.
+func (vars ServerVariablesProduction) ServerURL() (string, error) {
+	if vars.Env == "" {
+		vars.Env = "api"
+	}
+	switch vars.Env {
+	case "api", "staging":
+	default:
+		return "", fmt.Errorf("invalid value %q for server variable env", vars.Env)
+	}
+	if vars.Port == "" {
+		vars.Port = "443"
+	}
+	return strings.NewReplacer(
+		"{env}", vars.Env,
+		"{port}", vars.Port,
+	).Replace("https://{env}.example.com:{port}/v1"), nil
+}
+
+// ServerURLLocal returns the URL of the server
+// http://localhost:8080/v1.
+func ServerURLLocal() string {
+	return "http://localhost:8080/v1"
+}
+
+// ServerURLStaging returns the URL of the server
+// https://staging.example.com/v1.
+//
+// Staging
+func ServerURLStaging() string {
+	return "https://staging.example.com/v1"
+}
+
+// ServerURLLocal2 returns the URL of the server
+// http://localhost:9090/v1.
+func ServerURLLocal2() string {
+	return "http://localhost:9090/v1"
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /legacy/things)
+	GetLegacyThings(w http.ResponseWriter, r *http.Request) *Response
+
+	// (GET /things)
+	GetThings(w http.ResponseWriter, r *http.Request) *Response
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler          ServerInterface
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	Observer         runtime.Observer
+}
+
+// GetLegacyThings operation middleware
+func (siw *ServerInterfaceWrapper) GetLegacyThings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetLegacyThings", "/legacy/things")
+		defer call.End()
+		ctx = r.Context()
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetLegacyThings(w, r)
+		if resp != nil {
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetThings operation middleware
+func (siw *ServerInterfaceWrapper) GetThings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if siw.Observer != nil {
+		var call *runtime.Call
+		call, w, r = runtime.ObserveCall(siw.Observer, w, r, "GetThings", "/things")
+		defer call.End()
+		ctx = r.Context()
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetThings(w, r)
+		if resp != nil {
+			if resp.body != nil || resp.raw {
+				if err := resp.Render(w, r); err != nil {
+					siw.ErrorHandlerFunc(w, r, err)
+				}
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	err       error
+	paramName string
+}
+
+// Error implements error.
+func (err UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter %s: %v", err.paramName, err.err)
+}
+
+func (err UnescapedCookieParamError) Unwrap() error { return err.err }
+
+type UnmarshalingParamError struct {
+	err       error
+	paramName string
+}
+
+// Error implements error.
+func (err UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("error unmarshaling parameter %s as JSON: %v", err.paramName, err.err)
+}
+
+func (err UnmarshalingParamError) Unwrap() error { return err.err }
+
+type RequiredParamError struct {
+	err       error
+	paramName string
+}
+
+// Error implements error.
+func (err RequiredParamError) Error() string {
+	if err.err == nil {
+		return fmt.Sprintf("query parameter %s is required, but not found", err.paramName)
+	} else {
+		return fmt.Sprintf("query parameter %s is required, but errored: %s", err.paramName, err.err)
+	}
+}
+
+func (err RequiredParamError) Unwrap() error { return err.err }
+
+type RequiredHeaderError struct {
+	paramName string
+}
+
+// Error implements error.
+func (err RequiredHeaderError) Error() string {
+	return fmt.Sprintf("header parameter %s is required, but not found", err.paramName)
+}
+
+type InvalidParamFormatError struct {
+	err       error
+	paramName string
+}
+
+// Error implements error.
+func (err InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("invalid format for parameter %s: %v", err.paramName, err.err)
+}
+
+func (err InvalidParamFormatError) Unwrap() error { return err.err }
+
+type TooManyValuesForParamError struct {
+	NumValues int
+	paramName string
+}
+
+// Error implements error.
+func (err TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("expected one value for %s, got %d", err.paramName, err.NumValues)
+}
+
+// ParameterName is an interface that is implemented by error types that are
+// relevant to a specific parameter.
+type ParameterError interface {
+	error
+	// ParamName is the name of the parameter that the error is referring to.
+	ParamName() string
+}
+
+func (err UnescapedCookieParamError) ParamName() string  { return err.paramName }
+func (err UnmarshalingParamError) ParamName() string     { return err.paramName }
+func (err RequiredParamError) ParamName() string         { return err.paramName }
+func (err RequiredHeaderError) ParamName() string        { return err.paramName }
+func (err InvalidParamFormatError) ParamName() string    { return err.paramName }
+func (err TooManyValuesForParamError) ParamName() string { return err.paramName }
+
+type ServerOptions struct {
+	BaseURL           string
+	OperationBaseURLs map[string]string
+	BaseRouter        chi.Router
+	ErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	Observer          runtime.Observer
+}
+
+type ServerOption func(*ServerOptions)
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+// It panics if the options are invalid, such as when a tagged middleware is missing.
+func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
+	h, err := NewHandler(si, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// NewHandler creates http.Handler with routing matching OpenAPI spec.
+// An error is returned if the options are invalid, such as when a tagged middleware is missing.
+func NewHandler(si ServerInterface, opts ...ServerOption) (http.Handler, error) {
+	options := &ServerOptions{
+		BaseURL: defaultBaseURL,
+		OperationBaseURLs: map[string]string{
+			"GetLegacyThings": "/v0",
+		},
+		BaseRouter: chi.NewRouter(),
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			status := http.StatusBadRequest
+			http.Error(w, err.Error(), status)
+		},
+	}
+
+	for _, f := range opts {
+		f(options)
+	}
+
+	r := options.BaseRouter
+	wrapper := ServerInterfaceWrapper{
+		Handler:          si,
+		ErrorHandlerFunc: options.ErrorHandlerFunc,
+		Observer:         options.Observer,
+	}
+
+	// Errors, such as parameter binding errors, are recorded on the observed
+	// call of the operation.
+	wrapper.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+		runtime.SetCallError(r.Context(), err)
+		options.ErrorHandlerFunc(w, r, err)
+	}
+
+	r.Route(options.BaseURL, func(r chi.Router) {
+		r.Get("/things", wrapper.GetThings)
+	})
+	// GetLegacyThings is served under the base URL of its own servers.
+	r.Get(strings.TrimSuffix(options.OperationBaseURLs["GetLegacyThings"], "/")+"/legacy/things", wrapper.GetLegacyThings)
+	return r, nil
+}
+
+func WithRouter(r chi.Router) ServerOption {
+	return func(s *ServerOptions) {
+		s.BaseRouter = r
+	}
+}
+
+func WithServerBaseURL(url string) ServerOption {
+	return func(s *ServerOptions) {
+		s.BaseURL = url
+	}
+}
+
+// WithOperationBaseURL sets the base URL of an operation with servers of its
+// own, which is served under the path of its first server by default.
+func WithOperationBaseURL(operationID, url string) ServerOption {
+	return func(s *ServerOptions) {
+		if s.OperationBaseURLs == nil {
+			s.OperationBaseURLs = make(map[string]string)
+		}
+		s.OperationBaseURLs[operationID] = url
+	}
+}
+
+func WithErrorHandler(handler func(w http.ResponseWriter, r *http.Request, err error)) ServerOption {
+	return func(s *ServerOptions) {
+		s.ErrorHandlerFunc = handler
+	}
+}
+
+// WithObserver reports each call of an operation to o once it returns, with
+// its operation ID, method, route template, status code, response size,
+// duration and the error passed to the error handler, if any.
+func WithObserver(o runtime.Observer) ServerOption {
+	return func(s *ServerOptions) {
+		s.Observer = o
+	}
+}
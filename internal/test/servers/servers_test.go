@@ -0,0 +1,62 @@
+package servers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type server struct{}
+
+func (server) GetThings(w http.ResponseWriter, r *http.Request) *Response {
+	return &Response{Code: http.StatusNoContent}
+}
+
+func (server) GetLegacyThings(w http.ResponseWriter, r *http.Request) *Response {
+	return &Response{Code: http.StatusNoContent}
+}
+
+func TestServerURLs(t *testing.T) {
+	assert.Equal(t, "https://api.example.com:443/v1", ServerURLProduction())
+	assert.Equal(t, "http://localhost:8080/v1", ServerURLLocal())
+	assert.Equal(t, "https://staging.example.com/v1", ServerURLStaging())
+	assert.Equal(t, "http://localhost:9090/v1", ServerURLLocal2())
+
+	u, err := ServerVariablesProduction{Env: "staging", Port: "8443"}.ServerURL()
+	require.NoError(t, err)
+	assert.Equal(t, "https://staging.example.com:8443/v1", u)
+
+	u, err = ServerVariablesProduction{}.ServerURL()
+	require.NoError(t, err)
+	assert.Equal(t, ServerURLProduction(), u)
+
+	_, err = ServerVariablesProduction{Env: "dev"}.ServerURL()
+	assert.EqualError(t, err, `invalid value "dev" for server variable env`)
+}
+
+func TestBaseURL(t *testing.T) {
+	tests := []struct {
+		name string
+		h    http.Handler
+		path string
+		code int
+	}{
+		{name: "spec base URL", h: Handler(server{}), path: "/v1/things", code: http.StatusNoContent},
+		{name: "outside base URL", h: Handler(server{}), path: "/things", code: http.StatusNotFound},
+		{name: "operation servers", h: Handler(server{}), path: "/v0/legacy/things", code: http.StatusNoContent},
+		{name: "operation servers outside their base URL", h: Handler(server{}), path: "/v1/legacy/things", code: http.StatusNotFound},
+		{name: "base URL option", h: Handler(server{}, WithServerBaseURL("/")), path: "/things", code: http.StatusNoContent},
+		{name: "operation servers with base URL option", h: Handler(server{}, WithServerBaseURL("/")), path: "/v0/legacy/things", code: http.StatusNoContent},
+		{name: "operation base URL option", h: Handler(server{}, WithOperationBaseURL("GetLegacyThings", "/")), path: "/legacy/things", code: http.StatusNoContent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			tt.h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			assert.Equal(t, tt.code, rr.Code)
+		})
+	}
+}
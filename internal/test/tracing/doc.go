@@ -0,0 +1,5 @@
+package tracing
+
+// The generator is run from the root module, as this package is in a module of
+// its own so that OpenTelemetry isn't a dependency of the generator.
+//go:generate go -C ../../.. run . --generate=types,server,tracing --package=tracing -o internal/test/tracing/tracing.gen.go internal/test/tracing/tracing.yaml
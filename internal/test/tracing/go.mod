@@ -0,0 +1,23 @@
+module github.com/discord-gophers/goapi-gen/internal/test/tracing
+
+go 1.20
+
+require (
+	github.com/discord-gophers/goapi-gen v0.0.0-00010101000000-000000000000
+	github.com/go-chi/chi/v5 v5.0.4
+	github.com/stretchr/testify v1.9.0
+	go.opentelemetry.io/otel v1.10.0
+	go.opentelemetry.io/otel/sdk v1.10.0
+	go.opentelemetry.io/otel/trace v1.10.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/go-logr/logr v1.2.3 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/sys v0.2.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/discord-gophers/goapi-gen => ../../../
@@ -0,0 +1,93 @@
+package tracing
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type server struct{}
+
+func (server) GetThing(w http.ResponseWriter, r *http.Request, id int) *Response {
+	if id == 0 {
+		return &Response{Code: http.StatusInternalServerError}
+	}
+	return GetThingJSON200Response(Thing{ID: id})
+}
+
+func attributes(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	attrs := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func TestTracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	h := Handler(server{}, WithTracerProvider(tp))
+
+	t.Run("ok", func(t *testing.T) {
+		exporter.Reset()
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/things/42", nil))
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+		span := spans[0]
+		assert.Equal(t, "GetThing", span.Name)
+		assert.Equal(t, trace.SpanKindServer, span.SpanKind)
+		assert.Equal(t, codes.Unset, span.Status.Code)
+		assert.Empty(t, span.Events)
+
+		attrs := attributes(span)
+		assert.Equal(t, "/things/{id}", attrs["http.route"].AsString())
+		assert.Equal(t, http.MethodGet, attrs["http.method"].AsString())
+		assert.Equal(t, int64(http.StatusOK), attrs["http.status_code"].AsInt64())
+	})
+
+	t.Run("binding error", func(t *testing.T) {
+		exporter.Reset()
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/things/abc", nil))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+		span := spans[0]
+		assert.Equal(t, int64(http.StatusBadRequest), attributes(span)["http.status_code"].AsInt64())
+		require.Len(t, span.Events, 1)
+		assert.Equal(t, "exception", span.Events[0].Name)
+		assert.Contains(t, span.Events[0].Attributes,
+			attribute.String("exception.type", "*tracing.InvalidParamFormatError"))
+	})
+
+	t.Run("server error", func(t *testing.T) {
+		exporter.Reset()
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/things/0", nil))
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+		assert.Equal(t, codes.Error, spans[0].Status.Code)
+		assert.Equal(t, int64(http.StatusInternalServerError), attributes(spans[0])["http.status_code"].AsInt64())
+	})
+}
+
+func TestTracingNoop(t *testing.T) {
+	rr := httptest.NewRecorder()
+	Handler(server{}).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/things/42", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{"id":42}`, rr.Body.String())
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/discord-gophers/goapi-gen/codegen"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// job is a single generation of a configuration listing jobs.
+type job struct {
+	cfg     config
+	opts    codegen.Options
+	swagger *openapi3.T
+}
+
+func (j *job) run() error {
+	var code string
+	err := withInitialisms(j.cfg.Initialisms, func() (err error) {
+		code, err = codegen.Generate(j.swagger, j.cfg.Package, j.opts)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("could not generate code: %v", err)
+	}
+
+	if _, err := writeIfChanged(j.cfg.Out, code); err != nil {
+		return fmt.Errorf("could not write code: %v", err)
+	}
+	return nil
+}
+
+// runJobs runs all jobs of cfg. Specs are loaded one after the other, so that
+// external documents shared between them are only read once. The code of each
+// job is then generated with its own initialisms, which are registered
+// globally, so one job at a time, and written in parallel.
+func runJobs(cfg *config) error {
+	configs := jobConfigs(cfg)
+	jobs := make([]job, len(configs))
+	loader := newSpecLoader(cfg)
+	for i, jobCfg := range configs {
+		if jobCfg.Spec == "" {
+			return fmt.Errorf("job %d: spec required", i+1)
+		}
+		if jobCfg.Out == "" {
+			return fmt.Errorf("job %d (%s): output required", i+1, jobCfg.Spec)
+		}
+		if jobCfg.Package == "" {
+			jobCfg.Package = defaultPackage(jobCfg.Spec)
+		}
+
+		opts, err := generateOptions(&jobCfg)
+		if err != nil {
+			return fmt.Errorf("job %d (%s): %v", i+1, jobCfg.Spec, err)
+		}
+
+		swagger, err := loader.load(jobCfg.Spec)
+		if err != nil {
+			return fmt.Errorf("job %d (%s): %v", i+1, jobCfg.Spec, err)
+		}
+
+		jobs[i] = job{cfg: jobCfg, opts: opts, swagger: swagger}
+	}
+
+	errs := make([]error, len(jobs))
+	var wg sync.WaitGroup
+	for i := range jobs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = jobs[i].run()
+		}(i)
+	}
+	wg.Wait()
+
+	var msgs []string
+	for i, err := range errs {
+		if err != nil {
+			msgs = append(msgs, fmt.Sprintf("job %d (%s): %v", i+1, jobs[i].cfg.Spec, err))
+		}
+	}
+	if len(msgs) != 0 {
+		return errors.New(strings.Join(msgs, "\n"))
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobConfigs(t *testing.T) {
+	yes, no := true, false
+	cfg := &config{
+		Spec:          "api.yaml",
+		Package:       "api",
+		Generate:      []string{"types", "server"},
+		Templates:     "templates",
+		ImportMapping: map[string]string{"./common.yaml": "example.com/common"},
+		Alias:         &yes,
+		Initialisms:   []string{"isbn"},
+		CacheDir:      "cache",
+		Offline:       true,
+		Jobs: []config{
+			{Out: "api.gen.go"},
+			{
+				Spec:          "other.yaml",
+				Package:       "other",
+				Generate:      []string{"types"},
+				ImportMapping: map[string]string{},
+				Alias:         &no,
+				Initialisms:   []string{},
+				CacheDir:      "ignored",
+				Out:           "other.gen.go",
+			},
+		},
+	}
+
+	jobs := jobConfigs(cfg)
+	require.Len(t, jobs, 2)
+	assert.Equal(t, config{
+		Spec:          "api.yaml",
+		Package:       "api",
+		Generate:      []string{"types", "server"},
+		Out:           "api.gen.go",
+		Templates:     "templates",
+		ImportMapping: map[string]string{"./common.yaml": "example.com/common"},
+		Alias:         &yes,
+		Initialisms:   []string{"isbn"},
+		CacheDir:      "cache",
+		Offline:       true,
+	}, jobs[0])
+	assert.Equal(t, config{
+		Spec:          "other.yaml",
+		Package:       "other",
+		Generate:      []string{"types"},
+		Out:           "other.gen.go",
+		Templates:     "templates",
+		ImportMapping: map[string]string{},
+		Alias:         &no,
+		Initialisms:   []string{},
+		CacheDir:      "cache",
+		Offline:       true,
+	}, jobs[1])
+}
+
+func TestRunJobsErrors(t *testing.T) {
+	dir := t.TempDir()
+	spec := filepath.Join(dir, "spec.yaml")
+	writeFile(t, spec, watchedSpec)
+
+	tests := []struct {
+		name string
+		job  config
+		want string
+	}{
+		{name: "no spec", job: config{Out: "out.go"}, want: "job 1: spec required"},
+		{name: "no output", job: config{Spec: spec}, want: "job 1 (" + spec + "): output required"},
+		{
+			name: "unknown option",
+			job:  config{Spec: spec, Out: "out.go", Generate: []string{"client"}},
+			want: "job 1 (" + spec + "): unknown generation option: client",
+		},
+		{
+			name: "invalid initialism",
+			job:  config{Spec: spec, Out: "out.go", Initialisms: []string{""}},
+			want: "job 1 (" + spec + "): could not add initialism",
+		},
+		{
+			name: "missing spec",
+			job:  config{Spec: filepath.Join(dir, "missing.yaml"), Out: "out.go"},
+			want: "job 1 (" + filepath.Join(dir, "missing.yaml") + "): could not load spec",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := runJobs(&config{Jobs: []config{tt.job}})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestRunJobs(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "spec.yaml"), watchedSpec)
+	writeFile(t, filepath.Join(dir, "things.yaml"), `paths:
+  /things:
+    get:
+      operationId: getThings
+      responses:
+        204:
+          description: Things
+`)
+
+	cfg := &config{
+		Generate: []string{"types", "server"},
+		Jobs: []config{
+			{Spec: filepath.Join(dir, "spec.yaml"), Package: "a", Out: filepath.Join(dir, "a.gen.go")},
+			{Spec: filepath.Join(dir, "spec.yaml"), Package: "b", Out: filepath.Join(dir, "b.gen.go")},
+		},
+	}
+	require.NoError(t, runJobs(cfg))
+	for _, name := range []string{"a", "b"} {
+		code, err := os.ReadFile(filepath.Join(dir, name+".gen.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(code), "package "+name)
+		assert.Contains(t, string(code), "GetThings(w")
+	}
+
+	// Generation modifies specs, so specs sharing external documents don't
+	// share their values.
+	writeFile(t, filepath.Join(dir, "other.yaml"), watchedSpec)
+	l := newSpecLoader(&config{})
+	a, err := l.load(filepath.Join(dir, "spec.yaml"))
+	require.NoError(t, err)
+	b, err := l.load(filepath.Join(dir, "other.yaml"))
+	require.NoError(t, err)
+	assert.NotSame(t, a.Paths["/things"].Get, b.Paths["/things"].Get)
+}
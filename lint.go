@@ -0,0 +1,60 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/discord-gophers/goapi-gen/codegen"
+	"github.com/urfave/cli/v2"
+)
+
+func lint(c *cli.Context, cfg *config) error {
+	opts, err := generateOptions(cfg)
+	if err != nil {
+		return err
+	}
+
+	swagger, err := loadSpec(cfg, c.Args().First())
+	if err != nil {
+		return err
+	}
+
+	var problems []codegen.LintProblem
+	err = withInitialisms(cfg.Initialisms, func() error {
+		problems = codegen.Lint(swagger, opts)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	switch format := c.String(FormatKey); format {
+	case "text":
+		for _, p := range problems {
+			fmt.Println(p)
+		}
+	case "json":
+		if problems == nil {
+			problems = []codegen.LintProblem{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(problems); err != nil {
+			return fmt.Errorf("could not write problems: %v", err)
+		}
+	default:
+		return fmt.Errorf("unknown output format: %s", format)
+	}
+
+	var errs int
+	for _, p := range problems {
+		if p.Severity == codegen.LintError {
+			errs++
+		}
+	}
+	if errs > 0 {
+		return fmt.Errorf("found %d error(s) in spec", errs)
+	}
+	return nil
+}
@@ -21,8 +21,11 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/discord-gophers/goapi-gen/codegen"
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/kenshaw/snaker"
 	"github.com/urfave/cli/v2"
 )
@@ -37,37 +40,108 @@ const (
 	ExcludeTagsKey    = "exclude-tags"
 	TemplatesKey      = "templates"
 	ImportMappingKey  = "import-mapping"
+	TypeMappingsKey   = "type-mappings"
 	ExcludeSchemasKey = "exclude-schemas"
 	AliasKey          = "alias"
 	InitialismsKey    = "initialisms"
 	ConfigKey         = "config"
+	FormatKey         = "format"
+	IntervalKey       = "interval"
+	CacheDirKey       = "cache-dir"
+	OfflineKey        = "offline"
 )
 
 func run(c *cli.Context, cfg *config) error {
-	if c.Args().Len() == 0 && (cfg.Package == "" || cfg.Package == "-") {
+	if len(cfg.Jobs) != 0 {
+		if c.Args().Len() != 0 {
+			return errors.New("spec arguments can't be used with a configuration listing jobs")
+		}
+		return runJobs(cfg)
+	}
+
+	file := c.Args().First()
+	if file == "" {
+		file = cfg.Spec
+	}
+
+	if file == "" && (cfg.Package == "" || cfg.Package == "-") {
 		return errors.New("package required when reading from stdin")
 	}
 
 	if cfg.Package == "" {
-		path := c.Args().First()
-		baseName := filepath.Base(path)
-		nameParts := strings.Split(baseName, ".")
-		cfg.Package = codegen.ToSnakeCase(nameParts[0])
+		cfg.Package = defaultPackage(file)
 	}
 
-	var err error
-	in := os.Stdin
-	if file := c.Args().Get(0); file != "" {
-		in, err = os.Open(file)
-		if err != nil {
-			return fmt.Errorf("could not not open %s: %v", file, err)
+	code, _, err := generate(cfg, file)
+	if err != nil {
+		return err
+	}
+
+	if cfg.Out == "" {
+		if _, err := os.Stdout.WriteString(code); err != nil {
+			return fmt.Errorf("could not write code: %v", err)
 		}
-		defer in.Close()
+		return nil
+	}
+
+	if _, err := writeIfChanged(cfg.Out, code); err != nil {
+		return fmt.Errorf("could not write code: %v", err)
+	}
+	return nil
+}
+
+// defaultPackage returns the package name used for the spec at path when none
+// is configured.
+func defaultPackage(path string) string {
+	baseName := filepath.Base(path)
+	nameParts := strings.Split(baseName, ".")
+	return codegen.ToSnakeCase(nameParts[0])
+}
+
+// generate generates code for the spec in file with cfg. The local files read
+// to resolve external references of the spec are returned along with it.
+func generate(cfg *config, file string) (string, []string, error) {
+	opts, err := generateOptions(cfg)
+	if err != nil {
+		return "", nil, err
+	}
+
+	swagger, refs, err := loadSpecWithRefs(cfg, file)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var code string
+	err = withInitialisms(cfg.Initialisms, func() (err error) {
+		code, err = codegen.Generate(swagger, cfg.Package, opts)
+		return err
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("could not generate code: %v", err)
 	}
+	return code, refs, nil
+}
 
+// writeIfChanged writes code to the file at path, unless it already has that
+// content, so that its modification time is only updated on actual changes.
+// It reports whether the file was written.
+func writeIfChanged(path, code string) (bool, error) {
+	current, err := os.ReadFile(path)
+	if err == nil && string(current) == code {
+		return false, nil
+	}
+	if err := os.WriteFile(path, []byte(code), 0o644); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// generateOptions converts cfg to the options used by the codegen package.
+// It also validates the custom initialisms of cfg.
+func generateOptions(cfg *config) (codegen.Options, error) {
 	templates, err := parseTemplateOverrides(cfg.Templates)
 	if err != nil {
-		return fmt.Errorf("could not open templates: %s", err)
+		return codegen.Options{}, fmt.Errorf("could not open templates: %s", err)
 	}
 
 	opts := codegen.Options{
@@ -76,6 +150,8 @@ func run(c *cli.Context, cfg *config) error {
 		ExcludeSchemas: cfg.ExcludeSchemas,
 		UserTemplates:  templates,
 		ImportMapping:  cfg.ImportMapping,
+		TypeMappings:   cfg.TypeMappings,
+		AliasTypes:     cfg.Alias != nil && *cfg.Alias,
 	}
 
 	for _, tgt := range cfg.Generate {
@@ -86,59 +162,77 @@ func run(c *cli.Context, cfg *config) error {
 			opts.GenerateTypes = true
 		case "spec":
 			opts.EmbedSpec = true
+		case "bundle":
+			opts.BundleSpec = true
 		case "skip-fmt":
 			opts.SkipFmt = true
 		case "skip-prune":
 			opts.SkipPrune = true
+		case "tracing":
+			opts.Tracing = true
+		case "errors":
+			opts.ReturnErrors = true
+		case "collect-errors":
+			opts.CollectErrors = true
 		default:
-			return fmt.Errorf("unknown generation option: %s", tgt)
+			return codegen.Options{}, fmt.Errorf("unknown generation option: %s", tgt)
 		}
 	}
 
-	swagger, err := parseSwagger(in)
-	if err != nil {
-		return fmt.Errorf("could not load spec: %v", err)
+	if _, err := initialisms(cfg.Initialisms); err != nil {
+		return codegen.Options{}, err
 	}
 
-	// NOTE(hhhapz): This might need to be changed in the future.
-	// We might want to be more nitpicky about which minor versions we support,
-	// however, limiting the spec to this should be good enough to indicate to
-	// the end user that the thing causing their OpenAPI spec to fail is the
-	// version number.
-	split := strings.Split(swagger.OpenAPI, ".")
-	if split[0] != "3" {
-		return fmt.Errorf("unsupported OpenAPI version %s: only v3 is supported", split[0])
-	}
+	return opts, nil
+}
 
-	// Add any custom defined initialisms.
-	// This helps generated mode idiomatic code; i.e Isbn => ISBN
-	for _, ini := range cfg.Initialisms {
-		ini = strings.ToUpper(ini)
-		if err := snaker.DefaultInitialisms.Add(ini); err != nil {
-			return fmt.Errorf("could not add initialism %q: %v", ini, err)
-		}
-	}
+// generateMu guards the initialisms registered globally while generating code.
+var generateMu sync.Mutex
 
-	code, err := codegen.Generate(swagger, cfg.Package, opts)
+// initialisms returns the common initialisms along with custom, which helps
+// generating more idiomatic code; i.e Isbn => ISBN
+func initialisms(custom []string) (*snaker.Initialisms, error) {
+	ini, err := snaker.New(snaker.CommonInitialisms()...)
 	if err != nil {
-		return fmt.Errorf("could not generate code: %v", err)
+		return nil, err
 	}
-
-	out := os.Stdout
-	if cfg.Out != "" {
-		out, err = os.Create(cfg.Out)
-		if err != nil {
-			return fmt.Errorf("could not open output file: %v", err)
+	for _, s := range custom {
+		s = strings.ToUpper(s)
+		if err := ini.Add(s); err != nil {
+			return nil, fmt.Errorf("could not add initialism %q: %v", s, err)
 		}
-		defer out.Close()
 	}
+	return ini, nil
+}
 
-	_, err = out.WriteString(code)
+// withInitialisms calls fn with the custom initialisms registered, and
+// restores the previous ones afterwards, so that the initialisms of a job don't
+// affect the code generated for other jobs.
+func withInitialisms(custom []string, fn func() error) error {
+	ini, err := initialisms(custom)
 	if err != nil {
-		return fmt.Errorf("could not write code: %v", err)
+		return err
 	}
 
-	return nil
+	generateMu.Lock()
+	defer generateMu.Unlock()
+	prev := snaker.DefaultInitialisms
+	snaker.DefaultInitialisms = ini
+	defer func() { snaker.DefaultInitialisms = prev }()
+	return fn()
+}
+
+// loadSpec loads the spec in file, or from stdin if file is empty.
+func loadSpec(cfg *config, file string) (*openapi3.T, error) {
+	return newSpecLoader(cfg).load(file)
+}
+
+// loadSpecWithRefs is like loadSpec, but also returns the local files read to
+// resolve external references.
+func loadSpecWithRefs(cfg *config, file string) (*openapi3.T, []string, error) {
+	l := newSpecLoader(cfg)
+	swagger, err := l.load(file)
+	return swagger, l.files, err
 }
 
 func main() {
@@ -147,6 +241,7 @@ func main() {
 		IncludeTags:     &cli.StringSlice{},
 		ExcludeTags:     &cli.StringSlice{},
 		ImportMapping:   &cli.StringSlice{},
+		TypeMappings:    &cli.StringSlice{},
 		ExcludeSchemas:  &cli.StringSlice{},
 		Initialisms:     &cli.StringSlice{},
 	}
@@ -202,6 +297,11 @@ func main() {
 				Usage:       "A dict from the external reference to golang package path",
 				Destination: f.ImportMapping,
 			},
+			&cli.StringSliceFlag{
+				Name:        TypeMappingsKey,
+				Usage:       "A dict from the type/format of schemas to Go types",
+				Destination: f.TypeMappings,
+			},
 			&cli.StringSliceFlag{
 				Name:        ExcludeSchemasKey,
 				Aliases:     []string{"S"},
@@ -220,6 +320,17 @@ func main() {
 				Usage:       "Add custom initialisms (i.e ID, API, URI)",
 				Destination: f.Initialisms,
 			},
+			&cli.StringFlag{
+				Name:        CacheDirKey,
+				Usage:       "Cache specs and external references loaded from URLs in this directory",
+				DefaultText: "<none>",
+				Destination: &f.CacheDir,
+			},
+			&cli.BoolFlag{
+				Name:        OfflineKey,
+				Usage:       "Only use cached copies of specs and external references loaded from URLs",
+				Destination: &f.Offline,
+			},
 			&cli.StringFlag{
 				Name:        ConfigKey,
 				Aliases:     []string{"c"},
@@ -239,6 +350,82 @@ func main() {
 		},
 
 		Commands: []*cli.Command{
+			{
+				Name:      "lint",
+				Usage:     "report all problems in a spec which break code generation",
+				ArgsUsage: "<spec>",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  FormatKey,
+						Usage: "Output format, one of text or json",
+						Value: "text",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					cfg, err := parseConfig(c, f)
+					if err != nil {
+						return fmt.Errorf("could not parse args: %v", err)
+					}
+					return lint(c, cfg)
+				},
+			},
+			{
+				Name:      "diff",
+				Usage:     "report changes to the generated Go API between two versions of a spec",
+				ArgsUsage: "<old spec> <new spec>",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  FormatKey,
+						Usage: "Output format, one of text or json",
+						Value: "text",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					cfg, err := parseConfig(c, f)
+					if err != nil {
+						return fmt.Errorf("could not parse args: %v", err)
+					}
+					return diff(c, cfg)
+				},
+			},
+			{
+				Name:      "bundle",
+				Usage:     "write the spec with its external references moved into its components",
+				ArgsUsage: "<spec>",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:        FormatKey,
+						Usage:       "Output format, one of yaml or json",
+						DefaultText: "json for .json output files, yaml otherwise",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					cfg, err := parseConfig(c, f)
+					if err != nil {
+						return fmt.Errorf("could not parse args: %v", err)
+					}
+					return bundle(c, cfg)
+				},
+			},
+			{
+				Name:      "watch",
+				Usage:     "regenerate the output file whenever the spec, its references or the templates change",
+				ArgsUsage: "<spec>",
+				Flags: []cli.Flag{
+					&cli.DurationFlag{
+						Name:  IntervalKey,
+						Usage: "How often to check files for changes",
+						Value: 500 * time.Millisecond,
+					},
+				},
+				Action: func(c *cli.Context) error {
+					cfg, err := parseConfig(c, f)
+					if err != nil {
+						return fmt.Errorf("could not parse args: %v", err)
+					}
+					return watch(c, cfg)
+				},
+			},
 			{
 				Name:  "list",
 				Usage: "list available generation options",
@@ -1,10 +1,17 @@
 package main
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
 	"os"
 	"path"
+	"path/filepath"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
@@ -20,12 +27,16 @@ type flagConfig struct {
 	ExcludeTags     *cli.StringSlice
 	TemplatesDir    string
 	ImportMapping   *cli.StringSlice
+	TypeMappings    *cli.StringSlice
 	ExcludeSchemas  *cli.StringSlice
 	AliasTypes      bool
 	Initialisms     *cli.StringSlice
+	CacheDir        string
+	Offline         bool
 }
 
 type config struct {
+	Spec           string            `yaml:"spec"`
 	Package        string            `yaml:"package"`
 	Generate       []string          `yaml:"generate"`
 	Out            string            `yaml:"output"`
@@ -33,9 +44,16 @@ type config struct {
 	ExcludeTags    []string          `yaml:"exclude-tags"`
 	Templates      string            `yaml:"templates"`
 	ImportMapping  map[string]string `yaml:"import-mapping"`
+	TypeMappings   map[string]string `yaml:"type-mappings"`
 	ExcludeSchemas []string          `yaml:"exclude-schemas"`
-	Alias          bool              `yaml:"alias"`
+	Alias          *bool             `yaml:"alias"`
 	Initialisms    []string          `yaml:"initialisms"`
+	CacheDir       string            `yaml:"cache-dir"`
+	Offline        bool              `yaml:"offline"`
+
+	// Jobs are generated in a single invocation. The other values of the
+	// config are defaults shared by all jobs.
+	Jobs []config `yaml:"jobs"`
 }
 
 // parseConfig parses the flags and configuration file (if provided). all
@@ -81,19 +99,84 @@ func parseConfig(c *cli.Context, f *flagConfig) (*config, error) {
 		}
 		cfg.ImportMapping = mappings
 	}
+	if cfg.TypeMappings == nil || c.IsSet(TypeMappingsKey) {
+		mappings, err := parseMappings(f.TypeMappings)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse type mappings: %v", err)
+		}
+		cfg.TypeMappings = mappings
+	}
 	if cfg.ExcludeSchemas == nil || c.IsSet(ExcludeSchemasKey) {
 		cfg.ExcludeSchemas = splitString(f.ExcludeSchemas, ',')
 	}
 	if c.IsSet(AliasKey) {
-		cfg.Alias = f.AliasTypes
+		cfg.Alias = &f.AliasTypes
 	}
 	if cfg.Initialisms == nil || c.IsSet(InitialismsKey) {
 		cfg.Initialisms = splitString(f.Initialisms, ',')
 	}
+	if cfg.CacheDir == "" || c.IsSet(CacheDirKey) {
+		cfg.CacheDir = f.CacheDir
+	}
+	if c.IsSet(OfflineKey) {
+		cfg.Offline = f.Offline
+	}
+	if cfg.Offline && cfg.CacheDir == "" {
+		return nil, errors.New("offline mode requires a cache directory")
+	}
 
 	return &cfg, nil
 }
 
+// jobConfigs returns the configuration of every job in cfg. Values which are
+// not set by a job are taken from cfg.
+func jobConfigs(cfg *config) []config {
+	jobs := make([]config, len(cfg.Jobs))
+	for i, job := range cfg.Jobs {
+		if job.Spec == "" {
+			job.Spec = cfg.Spec
+		}
+		if job.Package == "" {
+			job.Package = cfg.Package
+		}
+		if job.Generate == nil {
+			job.Generate = cfg.Generate
+		}
+		if job.IncludeTags == nil {
+			job.IncludeTags = cfg.IncludeTags
+		}
+		if job.ExcludeTags == nil {
+			job.ExcludeTags = cfg.ExcludeTags
+		}
+		if job.Templates == "" {
+			job.Templates = cfg.Templates
+		}
+		if job.ExcludeSchemas == nil {
+			job.ExcludeSchemas = cfg.ExcludeSchemas
+		}
+		if job.Alias == nil {
+			job.Alias = cfg.Alias
+		}
+		if job.Initialisms == nil {
+			job.Initialisms = cfg.Initialisms
+		}
+
+		if job.ImportMapping == nil {
+			job.ImportMapping = cfg.ImportMapping
+		}
+		if job.TypeMappings == nil {
+			job.TypeMappings = cfg.TypeMappings
+		}
+		// All specs are loaded with the same loader.
+		job.CacheDir = cfg.CacheDir
+		job.Offline = cfg.Offline
+
+		job.Jobs = nil
+		jobs[i] = job
+	}
+	return jobs
+}
+
 func parseTemplateOverrides(templatesDir string) (map[string]string, error) {
 	templates := make(map[string]string)
 
@@ -134,16 +217,176 @@ func parseMappings(slice *cli.StringSlice) (map[string]string, error) {
 	return result, nil
 }
 
-func parseSwagger(in io.Reader) (swagger *openapi3.T, err error) {
+// specLoader loads specs along with their external references, from local
+// files or http(s) URLs. External documents shared by several specs loaded by
+// the same specLoader are only read once. They are parsed for each spec, as
+// generation modifies the spec along with the documents it references.
+type specLoader struct {
+	// cacheDir is where remote documents are cached, if set. In offline mode,
+	// remote documents are only read from there.
+	cacheDir string
+	offline  bool
+
+	// files are the local files read to resolve external references.
+	files []string
+	// contents are the documents read so far, by location.
+	contents map[string][]byte
+}
+
+func newSpecLoader(cfg *config) *specLoader {
+	return &specLoader{
+		cacheDir: cfg.CacheDir,
+		offline:  cfg.Offline,
+		contents: make(map[string][]byte),
+	}
+}
+
+func (l *specLoader) newLoader() *openapi3.Loader {
 	loader := openapi3.NewLoader()
 	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = l.readFromURI
+	return loader
+}
+
+// load loads the spec in file, which may also be a http(s) URL, or from stdin
+// if file is empty. Relative external references are resolved against the
+// location of the spec.
+func (l *specLoader) load(file string) (*openapi3.T, error) {
+	swagger, err := l.loadSwagger(file)
+	if err != nil {
+		return nil, fmt.Errorf("could not load spec: %v", err)
+	}
+
+	// NOTE(hhhapz): This might need to be changed in the future.
+	// We might want to be more nitpicky about which minor versions we support,
+	// however, limiting the spec to this should be good enough to indicate to
+	// the end user that the thing causing their OpenAPI spec to fail is the
+	// version number.
+	split := strings.Split(swagger.OpenAPI, ".")
+	if split[0] != "3" {
+		return nil, fmt.Errorf("unsupported OpenAPI version %s: only v3 is supported", split[0])
+	}
+
+	return swagger, nil
+}
+
+func (l *specLoader) loadSwagger(file string) (*openapi3.T, error) {
+	if file == "" {
+		buf, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, fmt.Errorf("could not read: %v", err)
+		}
+		return l.newLoader().LoadFromData(buf)
+	}
+
+	location := &url.URL{Path: filepath.ToSlash(file)}
+	if strings.HasPrefix(file, "http://") || strings.HasPrefix(file, "https://") {
+		var err error
+		location, err = url.Parse(file)
+		if err != nil {
+			return nil, fmt.Errorf("invalid spec URL: %v", err)
+		}
+	}
+
+	data, err := l.read(location)
+	if err != nil {
+		return nil, err
+	}
+
+	// A loader returns the same document every time a location is loaded,
+	// while generation modifies the spec and the documents it references, so
+	// every spec gets a loader of its own.
+	return l.newLoader().LoadFromDataWithPath(data, location)
+}
+
+func (l *specLoader) readFromURI(_ *openapi3.Loader, location *url.URL) ([]byte, error) {
+	if location.Scheme == "" && location.Host == "" {
+		l.files = append(l.files, filepath.FromSlash(location.Path))
+	}
+	return l.read(location)
+}
+
+// read reads the document at location, in the same way the default
+// openapi3.Loader does.
+func (l *specLoader) read(location *url.URL) ([]byte, error) {
+	key := location.String()
+	if data, ok := l.contents[key]; ok {
+		return data, nil
+	}
 
-	buf, err := io.ReadAll(in)
+	var data []byte
+	var err error
+	switch {
+	case (location.Scheme == "http" || location.Scheme == "https") && location.Host != "":
+		data, err = l.fetch(location)
+	case location.Scheme != "" || location.Host != "" || location.RawQuery != "":
+		return nil, fmt.Errorf("unsupported URI: %q", location)
+	default:
+		data, err = os.ReadFile(filepath.FromSlash(location.Path))
+	}
 	if err != nil {
-		return nil, fmt.Errorf("could not read: %v", err)
+		return nil, err
 	}
 
-	return loader.LoadFromData(buf)
+	l.contents[key] = data
+	return data, nil
+}
+
+// fetch downloads the remote document at location, and caches it if a cache
+// directory is configured. The cached copy is used when the download fails,
+// and in offline mode, only the cache is used.
+func (l *specLoader) fetch(location *url.URL) ([]byte, error) {
+	var cached string
+	if l.cacheDir != "" {
+		sum := sha256.Sum256([]byte(location.String()))
+		cached = filepath.Join(l.cacheDir, hex.EncodeToString(sum[:])+path.Ext(location.Path))
+	}
+
+	if l.offline {
+		data, err := os.ReadFile(cached)
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%s is not cached, and offline mode is enabled", location)
+		}
+		return data, err
+	}
+
+	data, err := download(location)
+	if err != nil {
+		if cached == "" {
+			return nil, err
+		}
+		if data, cacheErr := os.ReadFile(cached); cacheErr == nil {
+			return data, nil
+		}
+		return nil, err
+	}
+
+	if cached != "" {
+		if err := os.MkdirAll(l.cacheDir, 0o755); err != nil {
+			return nil, fmt.Errorf("could not create cache directory: %v", err)
+		}
+		if err := os.WriteFile(cached, data, 0o644); err != nil {
+			return nil, fmt.Errorf("could not cache %s: %v", location, err)
+		}
+	}
+	return data, nil
+}
+
+// download downloads the document at location.
+func download(location *url.URL) ([]byte, error) {
+	resp, err := http.Get(location.String())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode > 399 {
+		return nil, fmt.Errorf("error loading %q: request returned status code %d", location, resp.StatusCode)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error loading %q: %v", location, err)
+	}
+	return data, nil
 }
 
 // This function splits a string along the specifed separator, but it
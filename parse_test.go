@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpecLoaderFetch(t *testing.T) {
+	var failing int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&failing) != 0 {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/spec.yaml":
+			fmt.Fprint(w, watchedSpec)
+		case "/api/things.yaml":
+			fmt.Fprintf(w, watchedPath, "getThings")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	specURL := srv.URL + "/api/spec.yaml"
+
+	loaded := func(t *testing.T, cfg *config) {
+		t.Helper()
+		swagger, err := newSpecLoader(cfg).load(specURL)
+		require.NoError(t, err)
+		require.NotNil(t, swagger.Paths["/things"])
+		assert.Equal(t, "getThings", swagger.Paths["/things"].Get.OperationID)
+	}
+
+	// Relative references of a remote spec are fetched from its server.
+	loaded(t, &config{})
+
+	cacheDir := t.TempDir()
+	loaded(t, &config{CacheDir: cacheDir})
+	cached, err := os.ReadDir(cacheDir)
+	require.NoError(t, err)
+	assert.Len(t, cached, 2)
+
+	atomic.StoreInt32(&failing, 1)
+	loaded(t, &config{CacheDir: cacheDir, Offline: true})
+	loaded(t, &config{CacheDir: cacheDir})
+
+	_, err = newSpecLoader(&config{}).load(specURL)
+	assert.EqualError(t, err, fmt.Sprintf(`could not load spec: error loading %q: request returned status code 503`, specURL))
+
+	_, err = newSpecLoader(&config{CacheDir: t.TempDir(), Offline: true}).load(specURL)
+	assert.EqualError(t, err, fmt.Sprintf("could not load spec: %s is not cached, and offline mode is enabled", specURL))
+}
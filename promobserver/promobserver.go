@@ -0,0 +1,91 @@
+// Package promobserver records the calls of the operations of generated
+// servers in Prometheus metrics.
+package promobserver
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/discord-gophers/goapi-gen/runtime"
+)
+
+// Options configures the metrics of an Observer.
+type Options struct {
+	// Namespace prefixes the names of the metrics.
+	Namespace string
+	// DurationBuckets are the buckets of the duration histogram, in seconds.
+	// prometheus.DefBuckets is used if empty.
+	DurationBuckets []float64
+	// SizeBuckets are the buckets of the response size histogram, in bytes.
+	// Powers of 10 from 100B to 10MB are used if empty.
+	SizeBuckets []float64
+}
+
+// Observer is a runtime.Observer recording the calls of operations, labeled
+// by operation ID, method, route template and status code, in:
+//
+//   - http_requests_total, a counter of the calls,
+//   - http_request_duration_seconds, a histogram of their duration,
+//   - http_response_size_bytes, a histogram of the size of their response body.
+//
+// It is a prometheus.Collector to register with a prometheus.Registerer.
+type Observer struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	size     *prometheus.HistogramVec
+}
+
+var labels = []string{"operation", "method", "route", "code"}
+
+// New creates an Observer with the metrics configured by opts.
+func New(opts Options) *Observer {
+	if len(opts.DurationBuckets) == 0 {
+		opts.DurationBuckets = prometheus.DefBuckets
+	}
+	if len(opts.SizeBuckets) == 0 {
+		opts.SizeBuckets = prometheus.ExponentialBuckets(100, 10, 6)
+	}
+
+	return &Observer{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: opts.Namespace,
+			Name:      "http_requests_total",
+			Help:      "Number of calls of the operation.",
+		}, labels),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: opts.Namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Duration of the calls of the operation.",
+			Buckets:   opts.DurationBuckets,
+		}, labels),
+		size: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: opts.Namespace,
+			Name:      "http_response_size_bytes",
+			Help:      "Size of the response body of the calls of the operation.",
+			Buckets:   opts.SizeBuckets,
+		}, labels),
+	}
+}
+
+// Observe implements the runtime.Observer interface.
+func (o *Observer) Observe(obs runtime.Observation) {
+	values := []string{obs.OperationID, obs.Method, obs.Route, strconv.Itoa(obs.Status)}
+	o.requests.WithLabelValues(values...).Inc()
+	o.duration.WithLabelValues(values...).Observe(obs.Duration.Seconds())
+	o.size.WithLabelValues(values...).Observe(float64(obs.Size))
+}
+
+// Describe implements the prometheus.Collector interface.
+func (o *Observer) Describe(ch chan<- *prometheus.Desc) {
+	o.requests.Describe(ch)
+	o.duration.Describe(ch)
+	o.size.Describe(ch)
+}
+
+// Collect implements the prometheus.Collector interface.
+func (o *Observer) Collect(ch chan<- prometheus.Metric) {
+	o.requests.Collect(ch)
+	o.duration.Collect(ch)
+	o.size.Collect(ch)
+}
@@ -0,0 +1,68 @@
+package promobserver
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/discord-gophers/goapi-gen/runtime"
+)
+
+func TestObserver(t *testing.T) {
+	o := New(Options{Namespace: "petstore"})
+	reg := prometheus.NewPedanticRegistry()
+	require.NoError(t, reg.Register(o))
+
+	o.Observe(runtime.Observation{
+		OperationID: "FindPetByID",
+		Method:      http.MethodGet,
+		Route:       "/pets/{id}",
+		Status:      http.StatusOK,
+		Size:        512,
+		Duration:    20 * time.Millisecond,
+	})
+	o.Observe(runtime.Observation{
+		OperationID: "FindPetByID",
+		Method:      http.MethodGet,
+		Route:       "/pets/{id}",
+		Status:      http.StatusNotFound,
+		Duration:    time.Millisecond,
+	})
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(o.requests.WithLabelValues("FindPetByID", "GET", "/pets/{id}", "200")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(o.requests.WithLabelValues("FindPetByID", "GET", "/pets/{id}", "404")))
+
+	err := testutil.GatherAndCompare(reg, strings.NewReader(`
+# HELP petstore_http_response_size_bytes Size of the response body of the calls of the operation.
+# TYPE petstore_http_response_size_bytes histogram
+petstore_http_response_size_bytes_bucket{code="200",method="GET",operation="FindPetByID",route="/pets/{id}",le="100"} 0
+petstore_http_response_size_bytes_bucket{code="200",method="GET",operation="FindPetByID",route="/pets/{id}",le="1000"} 1
+petstore_http_response_size_bytes_bucket{code="200",method="GET",operation="FindPetByID",route="/pets/{id}",le="10000"} 1
+petstore_http_response_size_bytes_bucket{code="200",method="GET",operation="FindPetByID",route="/pets/{id}",le="100000"} 1
+petstore_http_response_size_bytes_bucket{code="200",method="GET",operation="FindPetByID",route="/pets/{id}",le="1e+06"} 1
+petstore_http_response_size_bytes_bucket{code="200",method="GET",operation="FindPetByID",route="/pets/{id}",le="1e+07"} 1
+petstore_http_response_size_bytes_bucket{code="200",method="GET",operation="FindPetByID",route="/pets/{id}",le="+Inf"} 1
+petstore_http_response_size_bytes_sum{code="200",method="GET",operation="FindPetByID",route="/pets/{id}"} 512
+petstore_http_response_size_bytes_count{code="200",method="GET",operation="FindPetByID",route="/pets/{id}"} 1
+petstore_http_response_size_bytes_bucket{code="404",method="GET",operation="FindPetByID",route="/pets/{id}",le="100"} 1
+petstore_http_response_size_bytes_bucket{code="404",method="GET",operation="FindPetByID",route="/pets/{id}",le="1000"} 1
+petstore_http_response_size_bytes_bucket{code="404",method="GET",operation="FindPetByID",route="/pets/{id}",le="10000"} 1
+petstore_http_response_size_bytes_bucket{code="404",method="GET",operation="FindPetByID",route="/pets/{id}",le="100000"} 1
+petstore_http_response_size_bytes_bucket{code="404",method="GET",operation="FindPetByID",route="/pets/{id}",le="1e+06"} 1
+petstore_http_response_size_bytes_bucket{code="404",method="GET",operation="FindPetByID",route="/pets/{id}",le="1e+07"} 1
+petstore_http_response_size_bytes_bucket{code="404",method="GET",operation="FindPetByID",route="/pets/{id}",le="+Inf"} 1
+petstore_http_response_size_bytes_sum{code="404",method="GET",operation="FindPetByID",route="/pets/{id}"} 0
+petstore_http_response_size_bytes_count{code="404",method="GET",operation="FindPetByID",route="/pets/{id}"} 1
+`), "petstore_http_response_size_bytes")
+	assert.NoError(t, err)
+
+	count, err := testutil.GatherAndCount(reg, "petstore_http_request_duration_seconds")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
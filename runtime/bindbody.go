@@ -0,0 +1,62 @@
+package runtime
+
+import (
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// BindBody decodes the body of r into dest, according to its Content-Type
+// header. JSON is assumed when the header is missing.
+//
+// JSON, XML and YAML bodies are decoded into dest. Other bodies, such as
+// text/plain or application/octet-stream ones, are read into dest as is, which
+// must then be a pointer to a string or a byte slice, or implement
+// encoding.TextUnmarshaler.
+func BindBody(r *http.Request, dest interface{}) error {
+	mediaType := "application/json"
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		var err error
+		mediaType, _, err = mime.ParseMediaType(contentType)
+		if err != nil {
+			return fmt.Errorf("invalid Content-Type %q: %w", contentType, err)
+		}
+	}
+
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		return json.NewDecoder(r.Body).Decode(dest)
+	case strings.HasSuffix(mediaType, "/xml") || strings.HasSuffix(mediaType, "+xml"):
+		return xml.NewDecoder(r.Body).Decode(dest)
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	if strings.HasSuffix(mediaType, "yaml") {
+		return UnmarshalYAML(data, dest)
+	}
+
+	if u, ok := dest.(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText(data)
+	}
+	v := reflect.ValueOf(dest)
+	if v.Kind() == reflect.Ptr && !v.IsNil() {
+		switch v = v.Elem(); {
+		case v.Kind() == reflect.String:
+			v.SetString(string(data))
+			return nil
+		case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+			v.SetBytes(data)
+			return nil
+		}
+	}
+	return fmt.Errorf("can't bind a %s body to %T", mediaType, dest)
+}
@@ -0,0 +1,80 @@
+package runtime
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/discord-gophers/goapi-gen/types"
+)
+
+func TestBindBody(t *testing.T) {
+	type pet struct {
+		Name string   `json:"name" xml:"name"`
+		Tags []string `json:"tags" xml:"tag"`
+	}
+	want := pet{Name: "Rex", Tags: []string{"good", "boy"}}
+
+	tests := []struct {
+		contentType string
+		body        string
+	}{
+		{contentType: "", body: `{"name":"Rex","tags":["good","boy"]}`},
+		{contentType: "application/json; charset=utf-8", body: `{"name":"Rex","tags":["good","boy"]}`},
+		{contentType: "application/merge-patch+json", body: `{"name":"Rex","tags":["good","boy"]}`},
+		{contentType: "application/xml", body: `<pet><name>Rex</name><tag>good</tag><tag>boy</tag></pet>`},
+		{contentType: "text/xml", body: `<pet><name>Rex</name><tag>good</tag><tag>boy</tag></pet>`},
+		{contentType: "application/yaml", body: "name: Rex\ntags: [good, boy]\n"},
+		{contentType: "text/x-yaml", body: "name: Rex\ntags:\n  - good\n  - boy\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.contentType, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/pets", strings.NewReader(tt.body))
+			r.Header.Set("Content-Type", tt.contentType)
+
+			var got pet
+			require.NoError(t, BindBody(r, &got))
+			assert.Equal(t, want, got)
+		})
+	}
+
+	t.Run("raw", func(t *testing.T) {
+		type note string
+		r := httptest.NewRequest("POST", "/notes", strings.NewReader("hello"))
+		r.Header.Set("Content-Type", "text/plain")
+		var text note
+		require.NoError(t, BindBody(r, &text))
+		assert.Equal(t, note("hello"), text)
+
+		r = httptest.NewRequest("POST", "/files", strings.NewReader("\x00\x01"))
+		r.Header.Set("Content-Type", "application/octet-stream")
+		var data []byte
+		require.NoError(t, BindBody(r, &data))
+		assert.Equal(t, []byte{0, 1}, data)
+
+		r = httptest.NewRequest("POST", "/files", strings.NewReader("\x00\x01"))
+		r.Header.Set("Content-Type", "image/png")
+		var file types.File
+		require.NoError(t, BindBody(r, &file))
+		content, err := file.Bytes()
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0, 1}, content)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		r := httptest.NewRequest("POST", "/pets", strings.NewReader(`{"name":`))
+		var p pet
+		assert.Error(t, BindBody(r, &p))
+
+		r = httptest.NewRequest("POST", "/pets", strings.NewReader("name: Rex"))
+		r.Header.Set("Content-Type", "text/plain")
+		assert.EqualError(t, BindBody(r, &p), "can't bind a text/plain body to *runtime.pet")
+
+		r = httptest.NewRequest("POST", "/pets", strings.NewReader("{}"))
+		r.Header.Set("Content-Type", "application/json; =")
+		assert.Error(t, BindBody(r, &p))
+	})
+}
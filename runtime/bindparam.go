@@ -46,23 +46,14 @@ func BindStyledParameterWithLocation(style string, explode bool, paramName strin
 		return fmt.Errorf("parameter '%s' is empty, can't bind its value", paramName)
 	}
 
+	// Arrays and objects are split before being unescaped, so that escaped
+	// separators are kept within their parts.
+	escaped := value
+
 	// Based on the location of the parameter, we need to unescape it properly.
-	var err error
-	switch paramLocation {
-	case ParamLocationQuery, ParamLocationUndefined:
-		// We unescape undefined parameter locations here for older generated code,
-		// since prior to this refactoring, they always query unescaped.
-		value, err = url.QueryUnescape(value)
-		if err != nil {
-			return fmt.Errorf("error unescaping query parameter '%s': %v", paramName, err)
-		}
-	case ParamLocationPath:
-		value, err = url.PathUnescape(value)
-		if err != nil {
-			return fmt.Errorf("error unescaping path parameter '%s': %v", paramName, err)
-		}
-	default:
-		// Headers and cookies aren't escaped.
+	value, err := unescapeParameter(paramName, paramLocation, value)
+	if err != nil {
+		return err
 	}
 
 	// If the destination implements encoding.TextUnmarshaler we use it for binding
@@ -83,7 +74,7 @@ func BindStyledParameterWithLocation(style string, explode bool, paramName strin
 	if t.Kind() == reflect.Struct {
 		// We've got a destination object, we'll create a JSON representation
 		// of the input value, and let the json library deal with the unmarshaling
-		parts, err := splitStyledParameter(style, explode, true, paramName, value)
+		parts, err := splitEscapedParameter(style, explode, true, paramName, paramLocation, escaped)
 		if err != nil {
 			return err
 		}
@@ -93,7 +84,7 @@ func BindStyledParameterWithLocation(style string, explode bool, paramName strin
 
 	if t.Kind() == reflect.Slice {
 		// Chop up the parameter into parts based on its style
-		parts, err := splitStyledParameter(style, explode, false, paramName, value)
+		parts, err := splitEscapedParameter(style, explode, false, paramName, paramLocation, escaped)
 		if err != nil {
 			return fmt.Errorf("error splitting input '%s' into parts: %v", value, err)
 		}
@@ -105,6 +96,45 @@ func BindStyledParameterWithLocation(style string, explode bool, paramName strin
 	return BindStringToObject(value, dest)
 }
 
+// JoinHeaderValues joins the values of the repeated lines of a header into a
+// single comma-separated list, which RFC 7230 defines as equivalent, removing
+// the optional whitespace around the elements of the list.
+func JoinHeaderValues(values []string) string {
+	if len(values) == 1 && !strings.ContainsAny(values[0], " \t") {
+		return values[0]
+	}
+	var parts []string
+	for _, value := range values {
+		for _, part := range strings.Split(value, ",") {
+			parts = append(parts, strings.Trim(part, " \t"))
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// splitEscapedParameter splits the escaped value of paramName like
+// splitStyledParameter, then unescapes each part according to paramLocation.
+// Unlike unescaping the whole value first, this keeps escaped separators, such
+// as %7C in a pipeDelimited value, within their parts.
+func splitEscapedParameter(style string, explode bool, object bool, paramName string,
+	paramLocation ParamLocation, value string) ([]string, error) {
+
+	if style == "spaceDelimited" && !explode && paramLocation == ParamLocationQuery {
+		// Spaces within parts are escaped as +, see delimiter.
+		value = strings.ReplaceAll(value, delimiter(style, paramLocation), " ")
+	}
+	parts, err := splitStyledParameter(style, explode, object, paramName, value)
+	if err != nil {
+		return nil, err
+	}
+	for i := range parts {
+		if parts[i], err = unescapeParameter(paramName, paramLocation, parts[i]); err != nil {
+			return nil, err
+		}
+	}
+	return parts, nil
+}
+
 // splitStyledParameter is a complex set of operations, but each given
 // parameter style can be packed together in multiple ways, using different
 // styles of separators, and different packing strategies based on the explode
@@ -188,11 +218,42 @@ func splitStyledParameter(style string, explode bool, object bool, paramName str
 			parts[i] = strings.TrimPrefix(parts[i], prefix)
 		}
 		return parts, nil
+	case "spaceDelimited", "pipeDelimited":
+		// These work like form, except that unexploded values are separated
+		// by spaces or pipes, and that there is no exploded object format:
+		// ?id=3|4|5 or ?id=role|admin|firstName|Alex
+		// ?id=3&id=4&id=5
+		if explode {
+			if object {
+				return nil, fmt.Errorf("parameter '%s' of style %s can't be an exploded object", paramName, style)
+			}
+			parts := strings.Split(value, "&")
+			prefix := paramName + "="
+			for i := range parts {
+				parts[i] = strings.TrimPrefix(parts[i], prefix)
+			}
+			return parts, nil
+		}
+		value = strings.TrimPrefix(value, paramName+"=")
+		return strings.Split(value, styleDelimiter(style)), nil
 	}
 
 	return nil, fmt.Errorf("unhandled parameter style: %s", style)
 }
 
+// styleDelimiter returns the separator of unexploded values of style, which
+// must be one of form, spaceDelimited or pipeDelimited.
+func styleDelimiter(style string) string {
+	switch style {
+	case "spaceDelimited":
+		return " "
+	case "pipeDelimited":
+		return "|"
+	default:
+		return ","
+	}
+}
+
 // Given a set of values as a slice, create a slice to hold them all, and
 // assign to each one by one.
 func bindSplitPartsToDestinationArray(parts []string, dest interface{}) error {
@@ -278,6 +339,27 @@ func bindSplitPartsToDestinationStruct(paramName string, parts []string, explode
 // parameter form.
 func BindQueryParameter(style string, explode bool, required bool, paramName string,
 	queryParams url.Values, dest interface{}) error {
+	return bindQueryParameter(style, explode, required, paramName, queryParams, nil, dest)
+}
+
+// BindRawQueryParameter is like BindQueryParameter, but takes the raw query of
+// the request, such as r.URL.RawQuery. Unexploded spaceDelimited and
+// pipeDelimited values are split before they are unescaped, so that escaped
+// delimiters, such as %7C in a pipeDelimited value, are kept in their values.
+func BindRawQueryParameter(style string, explode bool, required bool, paramName string,
+	rawQuery string, dest interface{}) error {
+
+	queryParams, _ := url.ParseQuery(rawQuery)
+	if !splitsRawQuery(style, explode) {
+		return bindQueryParameter(style, explode, required, paramName, queryParams, nil, dest)
+	}
+	return bindQueryParameter(style, explode, required, paramName, queryParams, rawQueryValues(rawQuery), dest)
+}
+
+// bindQueryParameter binds paramName like BindQueryParameter. Unexploded
+// values are split from rawParams, the escaped query parameters, if given.
+func bindQueryParameter(style string, explode bool, required bool, paramName string,
+	queryParams url.Values, rawParams url.Values, dest interface{}) error {
 
 	// dv = destination value.
 	dv := reflect.Indirect(reflect.ValueOf(dest))
@@ -319,8 +401,16 @@ func BindQueryParameter(style string, explode bool, required bool, paramName str
 	t := v.Type()
 	k := t.Kind()
 
+	// Types which parse themselves, such as types.UUID, are bound like
+	// primitives.
+	if isTextType(t) {
+		k = reflect.Invalid
+	}
+
 	switch style {
-	case "form":
+	case "form", "spaceDelimited", "pipeDelimited":
+		// spaceDelimited and pipeDelimited work like form, with a different
+		// separator for unexploded values, and no exploded objects.
 		var parts []string
 		if explode {
 			// ok, the explode case in query arguments is very, very annoying,
@@ -346,6 +436,9 @@ func BindQueryParameter(style string, explode bool, required bool, paramName str
 				// form style object binding doesn't tell us which arguments
 				// in the query string correspond to the object's fields. We'll
 				// try to bind field by field.
+				if style != "form" {
+					return fmt.Errorf("parameter '%s' of style %s can't be an exploded object", paramName, style)
+				}
 				err = bindParamsToExplodedObject(paramName, queryParams, output)
 			default:
 				// Primitive object case. We expect to have 1 value to
@@ -375,7 +468,11 @@ func BindQueryParameter(style string, explode bool, required bool, paramName str
 			return nil
 		}
 
-		values, found := queryParams[paramName]
+		source := queryParams
+		if rawParams != nil {
+			source = rawParams
+		}
+		values, found := source[paramName]
 		if !found {
 			if required {
 				return fmt.Errorf("query parameter '%s' is required", paramName)
@@ -386,9 +483,17 @@ func BindQueryParameter(style string, explode bool, required bool, paramName str
 		if len(values) != 1 {
 			return fmt.Errorf("parameter '%s' is not exploded, but is specified multiple times", paramName)
 		}
-		parts = strings.Split(values[0], ",")
 
 		var err error
+		if rawParams != nil {
+			parts, err = splitEscapedParameter(style, explode, k == reflect.Struct, paramName, ParamLocationQuery, values[0])
+			if err != nil {
+				return err
+			}
+		} else {
+			parts = strings.Split(values[0], styleDelimiter(style))
+		}
+
 		switch k {
 		case reflect.Slice:
 			err = bindSplitPartsToDestinationArray(parts, output)
@@ -418,8 +523,6 @@ func BindQueryParameter(style string, explode bool, required bool, paramName str
 			return errors.New("deepObjects must be exploded")
 		}
 		return UnmarshalDeepObject(dest, paramName, queryParams)
-	case "spaceDelimited", "pipeDelimited":
-		return fmt.Errorf("query arguments of style '%s' aren't yet supported", style)
 	default:
 		return fmt.Errorf("style '%s' on parameter '%s' is invalid", style, paramName)
 	}
@@ -495,5 +598,8 @@ func indirect(dest interface{}) (interface{}, reflect.Value, reflect.Type) {
 	if t.ConvertibleTo(reflect.TypeOf(types.Date{})) {
 		return dest, reflect.Value{}, nil
 	}
+	if isTextType(t) {
+		return dest, reflect.Value{}, nil
+	}
 	return nil, v, t
 }
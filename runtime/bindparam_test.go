@@ -291,6 +291,46 @@ func TestSplitParameter(t *testing.T) {
 		"role=admin&firstName=Alex")
 	assert.NoError(t, err)
 	assert.EqualValues(t, expectedExplodedObject, result)
+
+	//  ------------------- spaceDelimited and pipeDelimited styles -----------
+	result, err = splitStyledParameter("spaceDelimited",
+		false,
+		false,
+		"id",
+		"id=3 4 5")
+	assert.NoError(t, err)
+	assert.EqualValues(t, expectedArray, result)
+
+	result, err = splitStyledParameter("pipeDelimited",
+		false,
+		false,
+		"id",
+		"id=3|4|5")
+	assert.NoError(t, err)
+	assert.EqualValues(t, expectedArray, result)
+
+	result, err = splitStyledParameter("pipeDelimited",
+		false,
+		true,
+		"id",
+		"id=role|admin|firstName|Alex")
+	assert.NoError(t, err)
+	assert.EqualValues(t, expectedObject, result)
+
+	result, err = splitStyledParameter("pipeDelimited",
+		true,
+		false,
+		"id",
+		"id=3&id=4&id=5")
+	assert.NoError(t, err)
+	assert.EqualValues(t, expectedArray, result)
+
+	_, err = splitStyledParameter("spaceDelimited",
+		true,
+		true,
+		"id",
+		"role=admin&firstName=Alex")
+	assert.Error(t, err)
 }
 
 func TestBindQueryParameter(t *testing.T) {
@@ -379,6 +419,75 @@ func TestBindQueryParameter(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, expected, birthday)
 	})
+
+	t.Run("spaceDelimited", func(t *testing.T) {
+		// ?id=3%204%205
+		queryParams := url.Values{"id": {"3 4 5"}}
+		var ids []int
+		err := BindQueryParameter("spaceDelimited", false, true, "id", queryParams, &ids)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{3, 4, 5}, ids)
+
+		// ?id=3&id=4&id=5
+		queryParams = url.Values{"id": {"3", "4", "5"}}
+		ids = nil
+		err = BindQueryParameter("spaceDelimited", true, true, "id", queryParams, &ids)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{3, 4, 5}, ids)
+	})
+
+	t.Run("pipeDelimited", func(t *testing.T) {
+		queryParams := url.Values{"tags": {"a|b c|d"}}
+		var tags []string
+		err := BindQueryParameter("pipeDelimited", false, false, "tags", queryParams, &tags)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b c", "d"}, tags)
+
+		type Color struct {
+			R string `json:"R"`
+			G string `json:"G"`
+		}
+		queryParams = url.Values{"color": {"R|100|G|200"}}
+		var color *Color
+		err = BindQueryParameter("pipeDelimited", false, false, "color", queryParams, &color)
+		assert.NoError(t, err)
+		assert.Equal(t, &Color{R: "100", G: "200"}, color)
+
+		queryParams = url.Values{"R": {"100"}, "G": {"200"}}
+		color = nil
+		err = BindQueryParameter("pipeDelimited", true, false, "color", queryParams, &color)
+		assert.Error(t, err)
+	})
+
+	t.Run("text types", func(t *testing.T) {
+		queryParams := url.Values{
+			"timeout":       {"PT1M30S"},
+			"ids":           {"f81d4fae-7dec-11d0-a765-00a0c91e6bf6,00000000-0000-0000-0000-000000000001"},
+			"filter[since]": {"P1D"},
+		}
+
+		var timeout *types.Duration
+		err := BindQueryParameter("form", true, false, "timeout", queryParams, &timeout)
+		require.NoError(t, err)
+		assert.Equal(t, &types.Duration{Duration: 90 * time.Second}, timeout)
+
+		var ids []types.UUID
+		err = BindQueryParameter("form", false, true, "ids", queryParams, &ids)
+		require.NoError(t, err)
+		require.Len(t, ids, 2)
+		assert.Equal(t, "00000000-0000-0000-0000-000000000001", ids[1].String())
+
+		var filter struct {
+			Since types.Duration `json:"since"`
+		}
+		err = BindQueryParameter("deepObject", true, true, "filter", queryParams, &filter)
+		require.NoError(t, err)
+		assert.Equal(t, 24*time.Hour, filter.Since.Duration)
+
+		queryParams = url.Values{"timeout": {"90s"}}
+		err = BindQueryParameter("form", true, false, "timeout", queryParams, &timeout)
+		assert.Error(t, err)
+	})
 }
 
 func TestBindParameterViaAlias(t *testing.T) {
@@ -509,4 +618,92 @@ func TestBindStyledParameterWithLocation(t *testing.T) {
 		"12345678910", &dstBigNumber)
 	assert.NoError(t, err)
 	assert.Equal(t, *expectedBig, dstBigNumber)
+
+	var dstTags []string
+	err = BindStyledParameterWithLocation("spaceDelimited", false, "tags", ParamLocationQuery,
+		"tags=a%20b%20c", &dstTags)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, dstTags)
+
+	dstTags = nil
+	err = BindStyledParameterWithLocation("pipeDelimited", false, "tags", ParamLocationQuery,
+		"tags=a%7Cb|c", &dstTags)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a|b", "c"}, dstTags)
+}
+
+func TestStyledQueryArrayRoundTrip(t *testing.T) {
+	values := []string{"a|b", "c d", "e,f", "g&h"}
+	for _, style := range []string{"form", "spaceDelimited", "pipeDelimited"} {
+		for _, explode := range []bool{false, true} {
+			styled, err := StyleParamWithLocation(style, explode, "id", ParamLocationQuery, values)
+			require.NoError(t, err)
+
+			var dst []string
+			err = BindStyledParameterWithLocation(style, explode, "id", ParamLocationQuery, styled, &dst)
+			assert.NoError(t, err)
+			assert.Equal(t, values, dst, "%s explode=%t: %s", style, explode, styled)
+
+			parts, err := StyledParameterValuesWithLocation(style, explode, "id", ParamLocationQuery, styled)
+			assert.NoError(t, err)
+			assert.Equal(t, values, parts, "%s explode=%t: %s", style, explode, styled)
+		}
+	}
+}
+
+func TestRawQueryArrayRoundTrip(t *testing.T) {
+	// Unexploded form values are split after they are unescaped, so they
+	// can't hold commas.
+	values := []string{"a|b", "c d", "e,f", "g&h", "i+j", "k%20l"}
+	for _, style := range []string{"form", "spaceDelimited", "pipeDelimited"} {
+		for _, explode := range []bool{false, true} {
+			if style == "form" && !explode {
+				continue
+			}
+			styled, err := StyleParamWithLocation(style, explode, "id", ParamLocationQuery, values)
+			require.NoError(t, err)
+			rawQuery := "other=x%7Cy%20z&" + styled
+
+			var dst []string
+			err = BindRawQueryParameter(style, explode, true, "id", rawQuery, &dst)
+			assert.NoError(t, err)
+			assert.Equal(t, values, dst, "%s explode=%t: %s", style, explode, rawQuery)
+
+			parts, found, err := RawQueryParameterValues(style, explode, "id", rawQuery)
+			assert.NoError(t, err)
+			assert.True(t, found)
+			assert.Equal(t, values, parts, "%s explode=%t: %s", style, explode, rawQuery)
+		}
+	}
+
+	var dst []string
+	err := BindRawQueryParameter("pipeDelimited", false, true, "id", "other=1", &dst)
+	assert.EqualError(t, err, "query parameter 'id' is required")
+	err = BindRawQueryParameter("pipeDelimited", false, false, "id", "id=1&id=2", &dst)
+	assert.EqualError(t, err, "parameter 'id' is not exploded, but is specified multiple times")
+
+	value, found, err := RawQueryParameterValue("pipeDelimited", false, "id", "id=a%7Cb")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "a|b", value)
+	_, _, err = RawQueryParameterValue("pipeDelimited", false, "id", "id=a|b")
+	assert.EqualError(t, err, "multiple values for single value parameter 'id'")
+	_, found, err = RawQueryParameterValues("spaceDelimited", false, "id", "other=1")
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	var object struct {
+		Role string `json:"role"`
+		Name string `json:"firstName"`
+	}
+	err = BindRawQueryParameter("pipeDelimited", false, true, "id", "id=role|a%7Cb|firstName|Alex", &object)
+	assert.NoError(t, err)
+	assert.Equal(t, "a|b", object.Role)
+	assert.Equal(t, "Alex", object.Name)
+}
+
+func TestJoinHeaderValues(t *testing.T) {
+	assert.Equal(t, "3,4,5", JoinHeaderValues([]string{"3,4,5"}))
+	assert.Equal(t, "3,4,5", JoinHeaderValues([]string{"3, 4", "5"}))
+	assert.Equal(t, "role=admin,firstName=Alex", JoinHeaderValues([]string{"role=admin", "\tfirstName=Alex "}))
 }
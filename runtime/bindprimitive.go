@@ -0,0 +1,415 @@
+package runtime
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// The functions of this file bind parameters of primitive types, and arrays
+// of primitive types, without reflection. The generated code gets the value
+// of such a parameter with one of the ParameterValue functions, then parses
+// it with the Parse function of its type, as BindQueryParameter and
+// BindStyledParameter would.
+
+// QueryParameterValue returns the value of the primitive query parameter
+// paramName of style form, spaceDelimited or pipeDelimited, and whether it is
+// in queryParams.
+func QueryParameterValue(style string, explode bool, paramName string, queryParams url.Values) (string, bool, error) {
+	parts, found, err := queryParameterParts(style, explode, paramName, queryParams)
+	if err != nil || !found {
+		return "", found, err
+	}
+	if len(parts) != 1 {
+		return "", true, fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+	}
+	return parts[0], true, nil
+}
+
+// QueryParameterValues returns the values of the array query parameter
+// paramName of style form, spaceDelimited or pipeDelimited, and whether it is
+// in queryParams.
+func QueryParameterValues(style string, explode bool, paramName string, queryParams url.Values) ([]string, bool, error) {
+	return queryParameterParts(style, explode, paramName, queryParams)
+}
+
+// RawQueryParameterValue is like QueryParameterValue, but takes the raw query
+// of the request, such as r.URL.RawQuery. Unexploded spaceDelimited and
+// pipeDelimited values are split before they are unescaped, so that escaped
+// delimiters, such as %7C in a pipeDelimited value, are kept in their values.
+func RawQueryParameterValue(style string, explode bool, paramName string, rawQuery string) (string, bool, error) {
+	parts, found, err := rawQueryParameterParts(style, explode, paramName, rawQuery)
+	if err != nil || !found {
+		return "", found, err
+	}
+	if len(parts) != 1 {
+		return "", true, fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+	}
+	return parts[0], true, nil
+}
+
+// RawQueryParameterValues is like QueryParameterValues, but takes the raw
+// query of the request, such as r.URL.RawQuery, like RawQueryParameterValue.
+func RawQueryParameterValues(style string, explode bool, paramName string, rawQuery string) ([]string, bool, error) {
+	return rawQueryParameterParts(style, explode, paramName, rawQuery)
+}
+
+func rawQueryParameterParts(style string, explode bool, paramName string, rawQuery string) ([]string, bool, error) {
+	if !splitsRawQuery(style, explode) {
+		queryParams, _ := url.ParseQuery(rawQuery)
+		return queryParameterParts(style, explode, paramName, queryParams)
+	}
+
+	values, found := rawQueryValues(rawQuery)[paramName]
+	if !found || len(values) == 0 {
+		return nil, false, nil
+	}
+	if len(values) != 1 {
+		return nil, true, fmt.Errorf("parameter '%s' is not exploded, but is specified multiple times", paramName)
+	}
+	parts, err := splitEscapedParameter(style, explode, false, paramName, ParamLocationQuery, values[0])
+	return parts, true, err
+}
+
+// splitsRawQuery reports whether values of style are split from the raw
+// query, which is the case of unexploded spaceDelimited and pipeDelimited
+// values.
+func splitsRawQuery(style string, explode bool) bool {
+	return !explode && (style == "spaceDelimited" || style == "pipeDelimited")
+}
+
+// rawQueryValues parses rawQuery like url.ParseQuery, except that values are
+// left escaped. Pairs whose key can't be unescaped are skipped.
+func rawQueryValues(rawQuery string) url.Values {
+	values := make(url.Values)
+	for _, pair := range strings.Split(rawQuery, "&") {
+		if pair == "" || strings.Contains(pair, ";") {
+			continue
+		}
+		key, value := pair, ""
+		if i := strings.Index(pair, "="); i >= 0 {
+			key, value = pair[:i], pair[i+1:]
+		}
+		key, err := url.QueryUnescape(key)
+		if err != nil {
+			continue
+		}
+		values[key] = append(values[key], value)
+	}
+	return values
+}
+
+func queryParameterParts(style string, explode bool, paramName string, queryParams url.Values) ([]string, bool, error) {
+	switch style {
+	case "form", "spaceDelimited", "pipeDelimited":
+	default:
+		return nil, false, fmt.Errorf("style '%s' on parameter '%s' is invalid", style, paramName)
+	}
+
+	values, found := queryParams[paramName]
+	if !found || len(values) == 0 {
+		return nil, false, nil
+	}
+	if explode {
+		return values, true, nil
+	}
+	if len(values) != 1 {
+		return nil, true, fmt.Errorf("parameter '%s' is not exploded, but is specified multiple times", paramName)
+	}
+	return strings.Split(values[0], styleDelimiter(style)), true, nil
+}
+
+// StyledParameterValue returns the unescaped value of the primitive parameter
+// paramName, as BindStyledParameter binds it.
+func StyledParameterValue(style string, explode bool, paramName string, value string) (string, error) {
+	return StyledParameterValueWithLocation(style, explode, paramName, ParamLocationUndefined, value)
+}
+
+// StyledParameterValueWithLocation returns the unescaped value of the
+// primitive parameter paramName, as BindStyledParameterWithLocation binds it.
+func StyledParameterValueWithLocation(style string, explode bool, paramName string,
+	paramLocation ParamLocation, value string) (string, error) {
+
+	if value == "" {
+		return "", fmt.Errorf("parameter '%s' is empty, can't bind its value", paramName)
+	}
+	return unescapeParameter(paramName, paramLocation, value)
+}
+
+// StyledParameterValues returns the unescaped values of the array parameter
+// paramName, as BindStyledParameter binds them.
+func StyledParameterValues(style string, explode bool, paramName string, value string) ([]string, error) {
+	return StyledParameterValuesWithLocation(style, explode, paramName, ParamLocationUndefined, value)
+}
+
+// StyledParameterValuesWithLocation returns the unescaped values of the array
+// parameter paramName, as BindStyledParameterWithLocation binds them.
+func StyledParameterValuesWithLocation(style string, explode bool, paramName string,
+	paramLocation ParamLocation, value string) ([]string, error) {
+
+	if value == "" {
+		return nil, fmt.Errorf("parameter '%s' is empty, can't bind its value", paramName)
+	}
+	parts, err := splitEscapedParameter(style, explode, false, paramName, paramLocation, value)
+	if err != nil {
+		return nil, fmt.Errorf("error splitting input '%s' into parts: %v", value, err)
+	}
+	return parts, nil
+}
+
+// unescapeParameter unescapes value according to paramLocation. Parameters of
+// undefined locations are query unescaped for older generated code, since they
+// always were before locations were introduced.
+func unescapeParameter(paramName string, paramLocation ParamLocation, value string) (string, error) {
+	var err error
+	switch paramLocation {
+	case ParamLocationQuery, ParamLocationUndefined:
+		value, err = url.QueryUnescape(value)
+		if err != nil {
+			return "", fmt.Errorf("error unescaping query parameter '%s': %v", paramName, err)
+		}
+	case ParamLocationPath:
+		value, err = url.PathUnescape(value)
+		if err != nil {
+			return "", fmt.Errorf("error unescaping path parameter '%s': %v", paramName, err)
+		}
+	default:
+		// Headers and cookies aren't escaped.
+	}
+	return value, nil
+}
+
+func arrayElementError(err error) error {
+	return fmt.Errorf("error setting array element: %v", err)
+}
+
+// ParseInt parses s as an int.
+func ParseInt(s string) (int, error) {
+	v, err := strconv.ParseInt(s, 10, 0)
+	return int(v), err
+}
+
+// ParseInt8 parses s as an int8.
+func ParseInt8(s string) (int8, error) {
+	v, err := strconv.ParseInt(s, 10, 8)
+	return int8(v), err
+}
+
+// ParseInt16 parses s as an int16.
+func ParseInt16(s string) (int16, error) {
+	v, err := strconv.ParseInt(s, 10, 16)
+	return int16(v), err
+}
+
+// ParseInt32 parses s as an int32.
+func ParseInt32(s string) (int32, error) {
+	v, err := strconv.ParseInt(s, 10, 32)
+	return int32(v), err
+}
+
+// ParseInt64 parses s as an int64.
+func ParseInt64(s string) (int64, error) {
+	return strconv.ParseInt(s, 10, 64)
+}
+
+// ParseUint parses s as a uint.
+func ParseUint(s string) (uint, error) {
+	v, err := strconv.ParseUint(s, 10, 0)
+	return uint(v), err
+}
+
+// ParseUint8 parses s as a uint8.
+func ParseUint8(s string) (uint8, error) {
+	v, err := strconv.ParseUint(s, 10, 8)
+	return uint8(v), err
+}
+
+// ParseUint16 parses s as a uint16.
+func ParseUint16(s string) (uint16, error) {
+	v, err := strconv.ParseUint(s, 10, 16)
+	return uint16(v), err
+}
+
+// ParseUint32 parses s as a uint32.
+func ParseUint32(s string) (uint32, error) {
+	v, err := strconv.ParseUint(s, 10, 32)
+	return uint32(v), err
+}
+
+// ParseUint64 parses s as a uint64.
+func ParseUint64(s string) (uint64, error) {
+	return strconv.ParseUint(s, 10, 64)
+}
+
+// ParseFloat32 parses s as a float32.
+func ParseFloat32(s string) (float32, error) {
+	v, err := strconv.ParseFloat(s, 32)
+	return float32(v), err
+}
+
+// ParseFloat64 parses s as a float64.
+func ParseFloat64(s string) (float64, error) {
+	return strconv.ParseFloat(s, 64)
+}
+
+// ParseBool parses s as a bool.
+func ParseBool(s string) (bool, error) {
+	return strconv.ParseBool(s)
+}
+
+// ParseIntSlice parses each of values as an int.
+func ParseIntSlice(values []string) ([]int, error) {
+	s := make([]int, len(values))
+	for i, v := range values {
+		var err error
+		if s[i], err = ParseInt(v); err != nil {
+			return nil, arrayElementError(err)
+		}
+	}
+	return s, nil
+}
+
+// ParseInt8Slice parses each of values as an int8.
+func ParseInt8Slice(values []string) ([]int8, error) {
+	s := make([]int8, len(values))
+	for i, v := range values {
+		var err error
+		if s[i], err = ParseInt8(v); err != nil {
+			return nil, arrayElementError(err)
+		}
+	}
+	return s, nil
+}
+
+// ParseInt16Slice parses each of values as an int16.
+func ParseInt16Slice(values []string) ([]int16, error) {
+	s := make([]int16, len(values))
+	for i, v := range values {
+		var err error
+		if s[i], err = ParseInt16(v); err != nil {
+			return nil, arrayElementError(err)
+		}
+	}
+	return s, nil
+}
+
+// ParseInt32Slice parses each of values as an int32.
+func ParseInt32Slice(values []string) ([]int32, error) {
+	s := make([]int32, len(values))
+	for i, v := range values {
+		var err error
+		if s[i], err = ParseInt32(v); err != nil {
+			return nil, arrayElementError(err)
+		}
+	}
+	return s, nil
+}
+
+// ParseInt64Slice parses each of values as an int64.
+func ParseInt64Slice(values []string) ([]int64, error) {
+	s := make([]int64, len(values))
+	for i, v := range values {
+		var err error
+		if s[i], err = ParseInt64(v); err != nil {
+			return nil, arrayElementError(err)
+		}
+	}
+	return s, nil
+}
+
+// ParseUintSlice parses each of values as a uint.
+func ParseUintSlice(values []string) ([]uint, error) {
+	s := make([]uint, len(values))
+	for i, v := range values {
+		var err error
+		if s[i], err = ParseUint(v); err != nil {
+			return nil, arrayElementError(err)
+		}
+	}
+	return s, nil
+}
+
+// ParseUint8Slice parses each of values as a uint8.
+func ParseUint8Slice(values []string) ([]uint8, error) {
+	s := make([]uint8, len(values))
+	for i, v := range values {
+		var err error
+		if s[i], err = ParseUint8(v); err != nil {
+			return nil, arrayElementError(err)
+		}
+	}
+	return s, nil
+}
+
+// ParseUint16Slice parses each of values as a uint16.
+func ParseUint16Slice(values []string) ([]uint16, error) {
+	s := make([]uint16, len(values))
+	for i, v := range values {
+		var err error
+		if s[i], err = ParseUint16(v); err != nil {
+			return nil, arrayElementError(err)
+		}
+	}
+	return s, nil
+}
+
+// ParseUint32Slice parses each of values as a uint32.
+func ParseUint32Slice(values []string) ([]uint32, error) {
+	s := make([]uint32, len(values))
+	for i, v := range values {
+		var err error
+		if s[i], err = ParseUint32(v); err != nil {
+			return nil, arrayElementError(err)
+		}
+	}
+	return s, nil
+}
+
+// ParseUint64Slice parses each of values as a uint64.
+func ParseUint64Slice(values []string) ([]uint64, error) {
+	s := make([]uint64, len(values))
+	for i, v := range values {
+		var err error
+		if s[i], err = ParseUint64(v); err != nil {
+			return nil, arrayElementError(err)
+		}
+	}
+	return s, nil
+}
+
+// ParseFloat32Slice parses each of values as a float32.
+func ParseFloat32Slice(values []string) ([]float32, error) {
+	s := make([]float32, len(values))
+	for i, v := range values {
+		var err error
+		if s[i], err = ParseFloat32(v); err != nil {
+			return nil, arrayElementError(err)
+		}
+	}
+	return s, nil
+}
+
+// ParseFloat64Slice parses each of values as a float64.
+func ParseFloat64Slice(values []string) ([]float64, error) {
+	s := make([]float64, len(values))
+	for i, v := range values {
+		var err error
+		if s[i], err = ParseFloat64(v); err != nil {
+			return nil, arrayElementError(err)
+		}
+	}
+	return s, nil
+}
+
+// ParseBoolSlice parses each of values as a bool.
+func ParseBoolSlice(values []string) ([]bool, error) {
+	s := make([]bool, len(values))
+	for i, v := range values {
+		var err error
+		if s[i], err = ParseBool(v); err != nil {
+			return nil, arrayElementError(err)
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,192 @@
+package runtime
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryParameterValue(t *testing.T) {
+	query := url.Values{
+		"id":    {"5"},
+		"ids":   {"3", "4"},
+		"pipes": {"3|4|5"},
+	}
+
+	value, found, err := QueryParameterValue("form", true, "id", query)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "5", value)
+
+	_, found, err = QueryParameterValue("form", true, "missing", query)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	_, _, err = QueryParameterValue("form", true, "ids", query)
+	assert.EqualError(t, err, "multiple values for single value parameter 'ids'")
+
+	_, _, err = QueryParameterValue("deepObject", true, "id", query)
+	assert.Error(t, err)
+
+	values, found, err := QueryParameterValues("form", true, "ids", query)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []string{"3", "4"}, values)
+
+	values, _, err = QueryParameterValues("pipeDelimited", false, "pipes", query)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3", "4", "5"}, values)
+
+	_, _, err = QueryParameterValues("form", false, "ids", query)
+	assert.EqualError(t, err, "parameter 'ids' is not exploded, but is specified multiple times")
+}
+
+func TestStyledParameterValue(t *testing.T) {
+	value, err := StyledParameterValueWithLocation("simple", false, "id", ParamLocationPath, "a%20b")
+	require.NoError(t, err)
+	assert.Equal(t, "a b", value)
+
+	_, err = StyledParameterValue("simple", false, "id", "")
+	assert.EqualError(t, err, "parameter 'id' is empty, can't bind its value")
+
+	values, err := StyledParameterValues("label", true, "ids", ".3.4.5")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3", "4", "5"}, values)
+
+	_, err = StyledParameterValues("label", true, "ids", "3.4.5")
+	assert.Error(t, err)
+}
+
+func TestParsePrimitives(t *testing.T) {
+	i32, err := ParseInt32("-5")
+	require.NoError(t, err)
+	assert.Equal(t, int32(-5), i32)
+
+	_, err = ParseInt8("300")
+	assert.Error(t, err)
+
+	u, err := ParseUint16("65535")
+	require.NoError(t, err)
+	assert.Equal(t, uint16(65535), u)
+
+	f, err := ParseFloat32("1.5")
+	require.NoError(t, err)
+	assert.Equal(t, float32(1.5), f)
+
+	b, err := ParseBool("true")
+	require.NoError(t, err)
+	assert.True(t, b)
+
+	ints, err := ParseInt64Slice([]string{"3", "4", "5"})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 4, 5}, ints)
+
+	_, err = ParseIntSlice([]string{"3", "four"})
+	assert.EqualError(t, err, `error setting array element: strconv.ParseInt: parsing "four": invalid syntax`)
+}
+
+// The benchmarks compare binding primitive parameters by reflection, as
+// BindQueryParameter and BindStyledParameter do, with binding them by their
+// values.
+
+func BenchmarkBindQueryParameter(b *testing.B) {
+	query := url.Values{"limit": {"10"}, "tags": {"1", "2", "3"}}
+
+	b.Run("Primitive", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var limit *int32
+			if err := BindQueryParameter("form", true, false, "limit", query, &limit); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Array", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var tags []int32
+			if err := BindQueryParameter("form", true, false, "tags", query, &tags); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkQueryParameterValue(b *testing.B) {
+	query := url.Values{"limit": {"10"}, "tags": {"1", "2", "3"}}
+
+	b.Run("Primitive", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			raw, found, err := QueryParameterValue("form", true, "limit", query)
+			if err != nil || !found {
+				b.Fatal(err)
+			}
+			if _, err := ParseInt32(raw); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Array", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			raw, found, err := QueryParameterValues("form", true, "tags", query)
+			if err != nil || !found {
+				b.Fatal(err)
+			}
+			if _, err := ParseInt32Slice(raw); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkBindStyledParameter(b *testing.B) {
+	b.Run("Primitive", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var id int64
+			if err := BindStyledParameter("simple", false, "id", "12345", &id); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Array", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var ids []int64
+			if err := BindStyledParameter("simple", false, "ids", "1,2,3", &ids); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkStyledParameterValue(b *testing.B) {
+	b.Run("Primitive", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			raw, err := StyledParameterValue("simple", false, "id", "12345")
+			if err != nil {
+				b.Fatal(err)
+			}
+			if _, err := ParseInt64(raw); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Array", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			raw, err := StyledParameterValues("simple", false, "ids", "1,2,3")
+			if err != nil {
+				b.Fatal(err)
+			}
+			if _, err := ParseInt64Slice(raw); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -15,6 +15,7 @@
 package runtime
 
 import (
+	"encoding"
 	"errors"
 	"fmt"
 	"reflect"
@@ -58,6 +59,16 @@ func BindStringToObject(src string, dst interface{}) error {
 		return errors.New("destination is not settable")
 	}
 
+	// Types which parse themselves, such as types.UUID or the Go types of
+	// type mappings, are validated by their UnmarshalText.
+	if _, ok := v.Addr().Interface().(Binder); !ok && isTextType(t) {
+		err = v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(src))
+		if err != nil {
+			return fmt.Errorf("error binding string parameter: %s", err)
+		}
+		return nil
+	}
+
 	switch t.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		var val int64
@@ -152,3 +163,16 @@ func BindStringToObject(src string, dst interface{}) error {
 	}
 	return nil
 }
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// isTextType reports whether values of t parse themselves from text, such as
+// types.UUID or types.Duration, and are bound as primitives even if they are
+// structs. Times and dates are parsed by BindStringToObject instead, which
+// also accepts dates for times.
+func isTextType(t reflect.Type) bool {
+	if t.ConvertibleTo(reflect.TypeOf(time.Time{})) || t.ConvertibleTo(reflect.TypeOf(types.Date{})) {
+		return false
+	}
+	return reflect.PtrTo(t).Implements(textUnmarshalerType)
+}
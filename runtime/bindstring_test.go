@@ -15,6 +15,7 @@
 package runtime
 
 import (
+	"net"
 	"testing"
 	"time"
 
@@ -170,4 +171,20 @@ func TestBindStringToObject(t *testing.T) {
 	var dstEmbeddedMockBinder EmbeddedMockBinder
 	assert.NoError(t, BindStringToObject(dateString, &dstEmbeddedMockBinder))
 	assert.EqualValues(t, dateString, dstEmbeddedMockBinder.Time.Format("2006-01-02"))
+
+	// Checks whether other types implementing encoding.TextUnmarshaler work.
+	var ip net.IP
+	assert.NoError(t, BindStringToObject("10.0.0.1", &ip))
+	assert.Equal(t, "10.0.0.1", ip.String())
+	assert.Error(t, BindStringToObject("10.0.0", &ip))
+
+	// Checks whether the types which parse themselves are validated.
+	var uri types.URI
+	assert.NoError(t, BindStringToObject("https://example.com", &uri))
+	assert.Equal(t, types.URI("https://example.com"), uri)
+	assert.Error(t, BindStringToObject("example.com", &uri))
+
+	var optionalIP *types.IP
+	assert.NoError(t, BindStringToObject("::1", &optionalIP))
+	assert.Equal(t, "::1", optionalIP.String())
 }
@@ -200,6 +200,11 @@ func assignPathValues(dst interface{}, pathValues fieldOrValue) error {
 	iv := reflect.Indirect(v)
 	it := iv.Type()
 
+	// Types which parse themselves, such as types.UUID, are values.
+	if isTextType(it) {
+		return BindStringToObject(pathValues.value, dst)
+	}
+
 	switch it.Kind() {
 	case reflect.Slice:
 		sliceLength := len(pathValues.fields)
@@ -0,0 +1,42 @@
+package runtime
+
+import (
+	"io"
+	"net/http"
+)
+
+// MaxBytesBody is a request body limited by http.MaxBytesReader, which
+// records whether a read went past the limit.
+type MaxBytesBody struct {
+	body     io.ReadCloser
+	limit    int64
+	read     int64
+	exceeded bool
+}
+
+// NewMaxBytesBody limits the body of r to n bytes. Like http.MaxBytesReader,
+// reading past the limit returns an error and closes the connection once the
+// response is written.
+func NewMaxBytesBody(w http.ResponseWriter, r *http.Request, n int64) *MaxBytesBody {
+	return &MaxBytesBody{body: http.MaxBytesReader(w, r.Body, n), limit: n}
+}
+
+// Read implements the io.Reader interface.
+func (b *MaxBytesBody) Read(p []byte) (int, error) {
+	n, err := b.body.Read(p)
+	b.read += int64(n)
+	if err != nil && err != io.EOF && b.read >= b.limit {
+		b.exceeded = true
+	}
+	return n, err
+}
+
+// Close implements the io.Closer interface.
+func (b *MaxBytesBody) Close() error {
+	return b.body.Close()
+}
+
+// Exceeded returns whether a read went past the limit.
+func (b *MaxBytesBody) Exceeded() bool {
+	return b.exceeded
+}
@@ -0,0 +1,28 @@
+package runtime
+
+import (
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxBytesBody(t *testing.T) {
+	r := httptest.NewRequest("POST", "/", strings.NewReader("12345"))
+	body := NewMaxBytesBody(httptest.NewRecorder(), r, 5)
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+	assert.Equal(t, "12345", string(data))
+	assert.False(t, body.Exceeded())
+
+	r = httptest.NewRequest("POST", "/", strings.NewReader("123456"))
+	body = NewMaxBytesBody(httptest.NewRecorder(), r, 5)
+	data, err = io.ReadAll(body)
+	assert.Error(t, err)
+	assert.Equal(t, "12345", string(data))
+	assert.True(t, body.Exceeded())
+	assert.NoError(t, body.Close())
+}
@@ -0,0 +1,96 @@
+package runtime
+
+import (
+	"strconv"
+	"strings"
+)
+
+// NegotiateContentType returns the offered media type which best matches the
+// media ranges of an Accept header. A range's quality value applies to the
+// offers it is the most specific match for, and ties go to the earlier offer.
+// An empty header accepts the first offer, and "" is returned when no offer is
+// acceptable.
+func NegotiateContentType(accept string, offers ...string) string {
+	if len(offers) == 0 {
+		return ""
+	}
+	if strings.TrimSpace(accept) == "" {
+		return offers[0]
+	}
+
+	ranges := parseAccept(accept)
+	var best string
+	var bestQ float64
+	for _, offer := range offers {
+		if q := acceptQuality(ranges, offer); q > bestQ {
+			best, bestQ = offer, q
+		}
+	}
+	return best
+}
+
+type mediaRange struct {
+	typ, subtype string
+	q            float64
+}
+
+func parseAccept(accept string) []mediaRange {
+	var ranges []mediaRange
+	for _, part := range strings.Split(accept, ",") {
+		params := strings.Split(part, ";")
+		typ, subtype := splitMediaType(params[0])
+		if typ == "" {
+			continue
+		}
+
+		r := mediaRange{typ: typ, subtype: subtype, q: 1}
+		for _, param := range params[1:] {
+			kv := strings.SplitN(param, "=", 2)
+			if len(kv) != 2 || strings.TrimSpace(kv[0]) != "q" {
+				continue
+			}
+			if q, err := strconv.ParseFloat(strings.TrimSpace(kv[1]), 64); err == nil {
+				r.q = q
+			}
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges
+}
+
+// acceptQuality returns the quality value of the most specific range matching
+// mediaType, or 0 if none does.
+func acceptQuality(ranges []mediaRange, mediaType string) float64 {
+	typ, subtype := splitMediaType(mediaType)
+	q, specificity := 0.0, -1
+	for _, r := range ranges {
+		var s int
+		switch {
+		case r.typ == typ && r.subtype == subtype:
+			s = 2
+		case r.typ == typ && r.subtype == "*":
+			s = 1
+		case r.typ == "*" && r.subtype == "*":
+			s = 0
+		default:
+			continue
+		}
+		if s > specificity {
+			q, specificity = r.q, s
+		}
+	}
+	return q
+}
+
+// splitMediaType splits a media type without parameters into its lower case
+// type and subtype.
+func splitMediaType(mediaType string) (string, string) {
+	if i := strings.Index(mediaType, ";"); i >= 0 {
+		mediaType = mediaType[:i]
+	}
+	parts := strings.SplitN(strings.ToLower(strings.TrimSpace(mediaType)), "/", 2)
+	if len(parts) != 2 {
+		return "", ""
+	}
+	return parts[0], parts[1]
+}
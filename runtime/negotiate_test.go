@@ -0,0 +1,32 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiateContentType(t *testing.T) {
+	offers := []string{"application/json", "application/yaml"}
+
+	tests := []struct {
+		accept string
+		want   string
+	}{
+		{"", "application/json"},
+		{"*/*", "application/json"},
+		{"application/yaml", "application/yaml"},
+		{"Application/YAML; charset=utf-8", "application/yaml"},
+		{"application/json;q=0.5, application/yaml", "application/yaml"},
+		{"application/*;q=0.2, application/yaml;q=0.1", "application/json"},
+		{"*/*;q=0.1, application/yaml", "application/yaml"},
+		{"application/yaml;q=0, */*", "application/json"},
+		{"text/html", ""},
+		{"application/json;q=0", ""},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, NegotiateContentType(tt.accept, offers...), "Accept: %s", tt.accept)
+	}
+
+	assert.Equal(t, "", NegotiateContentType("*/*"))
+}
@@ -0,0 +1,81 @@
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Observation describes a call of an operation, reported to an Observer once
+// the call returns.
+type Observation struct {
+	OperationID string
+	Method      string
+	Route       string // Route template of the operation, such as /pets/{id}.
+	Status      int
+	Size        int64 // Number of bytes of the response body.
+	Duration    time.Duration
+	Err         error // Last error passed to the error handler of the server.
+}
+
+// Observer observes the calls of the operations of a server.
+type Observer interface {
+	Observe(Observation)
+}
+
+// ObserverFunc is an Observer calling itself.
+type ObserverFunc func(Observation)
+
+// Observe implements the Observer interface.
+func (f ObserverFunc) Observe(o Observation) {
+	f(o)
+}
+
+// Call is a call of an operation observed by an Observer.
+type Call struct {
+	observer    Observer
+	mu          sync.Mutex // Guards observation.Err, which a handler outliving its timeout may set.
+	observation Observation
+	start       time.Time
+	recorder    *StatusRecorder
+}
+
+type callKey struct{}
+
+// ObserveCall starts observing a call of the operation operationID served
+// with w and r. The call must be served with the returned http.ResponseWriter
+// and http.Request, then ended with End.
+func ObserveCall(o Observer, w http.ResponseWriter, r *http.Request, operationID, route string) (*Call, http.ResponseWriter, *http.Request) {
+	c := &Call{
+		observer: o,
+		observation: Observation{
+			OperationID: operationID,
+			Method:      r.Method,
+			Route:       route,
+		},
+		start:    time.Now(),
+		recorder: NewStatusRecorder(w),
+	}
+	return c, c.recorder, r.WithContext(context.WithValue(r.Context(), callKey{}, c))
+}
+
+// SetCallError records err as the error of the call observed in ctx, if any.
+func SetCallError(ctx context.Context, err error) {
+	if c, ok := ctx.Value(callKey{}).(*Call); ok {
+		c.mu.Lock()
+		c.observation.Err = err
+		c.mu.Unlock()
+	}
+}
+
+// End reports the call to its Observer.
+func (c *Call) End() {
+	c.mu.Lock()
+	c.observation.Status = c.recorder.Status()
+	c.observation.Size = c.recorder.Size()
+	c.observation.Duration = time.Since(c.start)
+	observation := c.observation
+	c.mu.Unlock()
+	c.observer.Observe(observation)
+}
@@ -0,0 +1,35 @@
+package runtime
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObserveCall(t *testing.T) {
+	var got []Observation
+	observer := ObserverFunc(func(o Observation) { got = append(got, o) })
+
+	rr := httptest.NewRecorder()
+	call, w, r := ObserveCall(observer, rr, httptest.NewRequest(http.MethodGet, "/pets/1", nil), "GetPet", "/pets/{id}")
+	errNotFound := errors.New("not found")
+	SetCallError(r.Context(), errNotFound)
+	http.Error(w, "not found", http.StatusNotFound)
+	call.End()
+
+	if assert.Len(t, got, 1) {
+		o := got[0]
+		assert.Equal(t, "GetPet", o.OperationID)
+		assert.Equal(t, http.MethodGet, o.Method)
+		assert.Equal(t, "/pets/{id}", o.Route)
+		assert.Equal(t, http.StatusNotFound, o.Status)
+		assert.Equal(t, int64(rr.Body.Len()), o.Size)
+		assert.Equal(t, errNotFound, o.Err)
+	}
+
+	// Errors outside of an observed call are ignored.
+	SetCallError(httptest.NewRequest(http.MethodGet, "/", nil).Context(), errNotFound)
+}
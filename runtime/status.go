@@ -0,0 +1,108 @@
+package runtime
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+)
+
+// StatusRecorder is an http.ResponseWriter which records the status code
+// written by a handler, and the size of the body.
+type StatusRecorder struct {
+	http.ResponseWriter
+	status int
+	size   int64
+}
+
+// NewStatusRecorder records the status code and body written to w.
+func NewStatusRecorder(w http.ResponseWriter) *StatusRecorder {
+	return &StatusRecorder{ResponseWriter: w}
+}
+
+// WriteHeader implements the http.ResponseWriter interface.
+func (w *StatusRecorder) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Write implements the http.ResponseWriter interface.
+func (w *StatusRecorder) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.size += int64(n)
+	return n, err
+}
+
+// Flush implements the http.Flusher interface if the underlying
+// http.ResponseWriter does.
+func (w *StatusRecorder) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		if w.status == 0 {
+			w.status = http.StatusOK
+		}
+		f.Flush()
+	}
+}
+
+// Hijack implements the http.Hijacker interface if the underlying
+// http.ResponseWriter does. The status code and size of what is written to
+// the hijacked connection aren't recorded.
+func (w *StatusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("runtime: http.Hijacker not implemented by the response writer")
+	}
+	return h.Hijack()
+}
+
+// ReadFrom implements the io.ReaderFrom interface, so that the underlying
+// http.ResponseWriter may still copy r efficiently.
+func (w *StatusRecorder) ReadFrom(r io.Reader) (int64, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	var n int64
+	var err error
+	if rf, ok := w.ResponseWriter.(io.ReaderFrom); ok {
+		n, err = rf.ReadFrom(r)
+	} else {
+		n, err = io.Copy(writerOnly{w.ResponseWriter}, r)
+	}
+	w.size += n
+	return n, err
+}
+
+// writerOnly hides the io.ReaderFrom of a writer from io.Copy.
+type writerOnly struct {
+	io.Writer
+}
+
+// Unwrap returns the underlying http.ResponseWriter.
+func (w *StatusRecorder) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// Status returns the status code written so far, or http.StatusOK if nothing
+// was written, as net/http responds when the handler returns.
+func (w *StatusRecorder) Status() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+// Size returns the number of bytes of the body written so far.
+func (w *StatusRecorder) Size() int64 {
+	return w.size
+}
+
+// Written reports whether the status code was written.
+func (w *StatusRecorder) Written() bool {
+	return w.status != 0
+}
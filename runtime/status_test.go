@@ -0,0 +1,81 @@
+package runtime
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusRecorder(t *testing.T) {
+	w := NewStatusRecorder(httptest.NewRecorder())
+	assert.False(t, w.Written())
+	assert.Equal(t, http.StatusOK, w.Status())
+
+	w.WriteHeader(http.StatusNotFound)
+	w.WriteHeader(http.StatusInternalServerError)
+	w.Write([]byte("not found"))
+	assert.True(t, w.Written())
+	assert.Equal(t, http.StatusNotFound, w.Status())
+	assert.Equal(t, int64(9), w.Size())
+
+	w = NewStatusRecorder(httptest.NewRecorder())
+	w.Write([]byte("ok"))
+	assert.Equal(t, http.StatusOK, w.Status())
+
+	rec := httptest.NewRecorder()
+	w = NewStatusRecorder(rec)
+	w.Flush()
+	assert.True(t, rec.Flushed)
+	assert.Equal(t, http.StatusOK, w.Status())
+}
+
+func TestStatusRecorderInterfaces(t *testing.T) {
+	var rw http.ResponseWriter = NewStatusRecorder(httptest.NewRecorder())
+	_, ok := rw.(http.Hijacker)
+	assert.True(t, ok)
+	_, ok = rw.(io.ReaderFrom)
+	assert.True(t, ok)
+
+	w := NewStatusRecorder(httptest.NewRecorder())
+	n, err := w.ReadFrom(strings.NewReader("copied"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), n)
+	assert.Equal(t, int64(6), w.Size())
+	assert.True(t, w.Written())
+	_, _, err = w.Hijack()
+	assert.Error(t, err)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		w := NewStatusRecorder(rw)
+		rc := http.NewResponseController(w)
+		if r.URL.Path == "/flush" {
+			_, _ = w.Write([]byte("flushed"))
+			assert.NoError(t, rc.Flush())
+			return
+		}
+		conn, buf, err := rc.Hijack()
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer conn.Close()
+		_, _ = buf.WriteString("HTTP/1.1 204 No Content\r\nConnection: close\r\n\r\n")
+		_ = buf.Flush()
+	}))
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL + "/flush")
+	if assert.NoError(t, err) {
+		body, _ := io.ReadAll(res.Body)
+		res.Body.Close()
+		assert.Equal(t, "flushed", string(body))
+	}
+	res, err = http.Get(srv.URL + "/hijack")
+	if assert.NoError(t, err) {
+		res.Body.Close()
+		assert.Equal(t, http.StatusNoContent, res.StatusCode)
+	}
+}
@@ -15,6 +15,7 @@
 package runtime
 
 import (
+	"encoding"
 	"errors"
 	"fmt"
 	"net/url"
@@ -55,6 +56,11 @@ func StyleParamWithLocation(style string, explode bool, paramName string, paramL
 		t = v.Type()
 	}
 
+	// Types which format themselves, such as types.UUID, are primitives.
+	if isTextType(t) {
+		return stylePrimitive(style, explode, paramName, paramLocation, v.Interface())
+	}
+
 	switch t.Kind() {
 	case reflect.Slice:
 		n := v.Len()
@@ -107,19 +113,12 @@ func styleSlice(style string, explode bool, paramName string, paramLocation Para
 		} else {
 			separator = ","
 		}
-	case "spaceDelimited":
+	case "spaceDelimited", "pipeDelimited":
 		prefix = fmt.Sprintf("%s=", paramName)
 		if explode {
 			separator = "&" + prefix
 		} else {
-			separator = " "
-		}
-	case "pipeDelimited":
-		prefix = fmt.Sprintf("%s=", paramName)
-		if explode {
-			separator = "&" + prefix
-		} else {
-			separator = "|"
+			separator = delimiter(style, paramLocation)
 		}
 	default:
 		return "", fmt.Errorf("unsupported style '%s'", style)
@@ -295,6 +294,12 @@ func processFieldDict(style string, explode bool, paramName string, paramLocatio
 			prefix = fmt.Sprintf("%s=", paramName)
 			separator = ","
 		}
+	case "spaceDelimited", "pipeDelimited":
+		if explode {
+			return "", fmt.Errorf("objects of style '%s' can't be exploded", style)
+		}
+		prefix = fmt.Sprintf("%s=", paramName)
+		separator = delimiter(style, paramLocation)
 	case "deepObject":
 		{
 			if !explode {
@@ -335,6 +340,19 @@ func stylePrimitive(style string, explode bool, paramName string, paramLocation
 	return prefix + escapeParameterString(strVal, paramLocation), nil
 }
 
+// delimiter returns the separator of unexploded spaceDelimited and
+// pipeDelimited values. Values themselves are escaped, so a space is escaped
+// as %20 in queries to tell the two apart.
+func delimiter(style string, paramLocation ParamLocation) string {
+	if style == "pipeDelimited" {
+		return "|"
+	}
+	if paramLocation == ParamLocationQuery {
+		return "%20"
+	}
+	return " "
+}
+
 // Converts a primitive value to a string. We need to do this based on the
 // Kind of an interface, not the Type to work with aliased types.
 func primitiveToString(value interface{}) (string, error) {
@@ -345,6 +363,14 @@ func primitiveToString(value interface{}) (string, error) {
 	t := v.Type()
 	kind := t.Kind()
 
+	if tm, ok := v.Interface().(encoding.TextMarshaler); ok && isTextType(t) {
+		text, err := tm.MarshalText()
+		if err != nil {
+			return "", err
+		}
+		return string(text), nil
+	}
+
 	switch kind {
 	case reflect.Int8, reflect.Int32, reflect.Int64, reflect.Int:
 		output = strconv.FormatInt(v.Int(), 10)
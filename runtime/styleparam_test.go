@@ -336,20 +336,22 @@ func TestStyleParam(t *testing.T) {
 
 	result, err = StyleParamWithLocation("spaceDelimited", false, "id", ParamLocationQuery, array)
 	assert.NoError(t, err)
-	assert.EqualValues(t, "id=3 4 5", result)
+	assert.EqualValues(t, "id=3%204%205", result)
 
 	result, err = StyleParamWithLocation("spaceDelimited", true, "id", ParamLocationQuery, array)
 	assert.NoError(t, err)
 	assert.EqualValues(t, "id=3&id=4&id=5", result)
 
 	result, err = StyleParamWithLocation("spaceDelimited", false, "id", ParamLocationQuery, object)
-	assert.Error(t, err)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "id=firstName%20Alex%20role%20admin", result)
 
 	result, err = StyleParamWithLocation("spaceDelimited", true, "id", ParamLocationQuery, object)
 	assert.Error(t, err)
 
 	result, err = StyleParamWithLocation("spaceDelimited", false, "id", ParamLocationQuery, dict)
-	assert.Error(t, err)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "id=firstName%20Alex%20role%20admin", result)
 
 	result, err = StyleParamWithLocation("spaceDelimited", true, "id", ParamLocationQuery, dict)
 	assert.Error(t, err)
@@ -395,13 +397,15 @@ func TestStyleParam(t *testing.T) {
 	assert.EqualValues(t, "id=3&id=4&id=5", result)
 
 	result, err = StyleParamWithLocation("pipeDelimited", false, "id", ParamLocationQuery, object)
-	assert.Error(t, err)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "id=firstName|Alex|role|admin", result)
 
 	result, err = StyleParamWithLocation("pipeDelimited", true, "id", ParamLocationQuery, object)
 	assert.Error(t, err)
 
 	result, err = StyleParamWithLocation("pipeDelimited", false, "id", ParamLocationQuery, dict)
-	assert.Error(t, err)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "id=firstName|Alex|role|admin", result)
 
 	result, err = StyleParamWithLocation("pipeDelimited", true, "id", ParamLocationQuery, dict)
 	assert.Error(t, err)
@@ -430,6 +434,14 @@ func TestStyleParam(t *testing.T) {
 	result, err = StyleParamWithLocation("pipeDelimited", true, "id", ParamLocationQuery, &date)
 	assert.Error(t, err)
 
+	result, err = StyleParamWithLocation("pipeDelimited", false, "id", ParamLocationQuery, []string{"a|b", "c d"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, "id=a%7Cb|c+d", result)
+
+	result, err = StyleParamWithLocation("spaceDelimited", false, "id", ParamLocationQuery, []string{"a|b", "c d"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, "id=a%7Cb%20c+d", result)
+
 	// ---------------------------  deepObject Style ---------------------------
 	result, err = StyleParamWithLocation("deepObject", false, "id", ParamLocationQuery, primitive)
 	assert.Error(t, err)
@@ -521,4 +533,15 @@ func TestStyleParam(t *testing.T) {
 	result, err = StyleParamWithLocation("simple", false, "id", ParamLocationQuery, object2)
 	assert.NoError(t, err)
 	assert.EqualValues(t, "firstName,Alex", result)
+
+	// Types which format themselves are primitives.
+	duration := types.Duration{Duration: 90 * time.Second}
+	result, err = StyleParamWithLocation("form", true, "timeout", ParamLocationQuery, &duration)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "timeout=PT1M30S", result)
+
+	ids := []types.UUID{{0xf8, 0x1d}, {}}
+	result, err = StyleParamWithLocation("simple", false, "ids", ParamLocationPath, ids)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "f81d0000-0000-0000-0000-000000000000,00000000-0000-0000-0000-000000000000", result)
 }
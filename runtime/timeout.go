@@ -0,0 +1,91 @@
+package runtime
+
+import (
+	"bytes"
+	"net/http"
+	"sync"
+)
+
+// ServeWithTimeout serves r with h until the context of r is done, like
+// http.TimeoutHandler. The response of h is buffered, and written to w once h
+// returns. If the context of r is done first, ServeWithTimeout returns false
+// without waiting for h and without writing to w, so that the caller may
+// respond instead, and later writes of h fail with http.ErrHandlerTimeout.
+//
+// As its response is buffered, h can't use optional interfaces of w, such as
+// http.Flusher or http.Hijacker.
+func ServeWithTimeout(h http.Handler, w http.ResponseWriter, r *http.Request) bool {
+	tw := &timeoutWriter{header: make(http.Header)}
+	done := make(chan struct{})
+	panicked := make(chan interface{}, 1)
+	go func() {
+		defer func() {
+			if p := recover(); p != nil {
+				panicked <- p
+			}
+		}()
+		h.ServeHTTP(tw, r)
+		close(done)
+	}()
+
+	select {
+	case p := <-panicked:
+		panic(p)
+	case <-done:
+		tw.mu.Lock()
+		defer tw.mu.Unlock()
+		dst := w.Header()
+		for k, v := range tw.header {
+			dst[k] = v
+		}
+		if tw.code != 0 {
+			w.WriteHeader(tw.code)
+		}
+		if tw.buf.Len() != 0 {
+			_, _ = w.Write(tw.buf.Bytes())
+		}
+		return true
+	case <-r.Context().Done():
+		tw.mu.Lock()
+		defer tw.mu.Unlock()
+		tw.timedOut = true
+		return false
+	}
+}
+
+// timeoutWriter buffers the response of the handler of ServeWithTimeout.
+type timeoutWriter struct {
+	mu       sync.Mutex
+	header   http.Header
+	buf      bytes.Buffer
+	code     int
+	timedOut bool
+}
+
+// Header implements the http.ResponseWriter interface.
+func (w *timeoutWriter) Header() http.Header {
+	return w.header
+}
+
+// WriteHeader implements the http.ResponseWriter interface.
+func (w *timeoutWriter) WriteHeader(code int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.timedOut || w.code != 0 {
+		return
+	}
+	w.code = code
+}
+
+// Write implements the http.ResponseWriter interface.
+func (w *timeoutWriter) Write(b []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.timedOut {
+		return 0, http.ErrHandlerTimeout
+	}
+	if w.code == 0 {
+		w.code = http.StatusOK
+	}
+	return w.buf.Write(b)
+}
@@ -0,0 +1,47 @@
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServeWithTimeout(t *testing.T) {
+	rr := httptest.NewRecorder()
+	ok := ServeWithTimeout(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte("created"))
+	}), rr, httptest.NewRequest("GET", "/", nil))
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusCreated, rr.Code)
+	assert.Equal(t, "text/plain", rr.Header().Get("Content-Type"))
+	assert.Equal(t, "created", rr.Body.String())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	written := make(chan error)
+	release := make(chan struct{})
+	rr = httptest.NewRecorder()
+	ok = ServeWithTimeout(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The handler ignores the deadline.
+		<-release
+		_, err := w.Write([]byte("late"))
+		written <- err
+	}), rr, httptest.NewRequest("GET", "/", nil).WithContext(ctx))
+	assert.False(t, ok)
+	assert.False(t, rr.Flushed)
+	assert.Empty(t, rr.Body.String())
+	close(release)
+	assert.Equal(t, http.ErrHandlerTimeout, <-written)
+
+	assert.PanicsWithValue(t, "boom", func() {
+		ServeWithTimeout(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			panic("boom")
+		}), httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	})
+}
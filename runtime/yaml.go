@@ -0,0 +1,49 @@
+package runtime
+
+import (
+	"encoding/json"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MarshalYAML returns the YAML encoding of v. The value is encoded as JSON
+// first, so that YAML documents have the same field names and use the same
+// custom marshalers as JSON ones, and the order of object keys is kept.
+func MarshalYAML(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	// JSON is valid YAML, in flow style.
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	blockStyle(&node)
+	return yaml.Marshal(&node)
+}
+
+// blockStyle resets the style of node and its children, so that they are
+// encoded in block style, with strings quoted only when necessary.
+func blockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
+
+// UnmarshalYAML parses the YAML document data into v. The document is decoded
+// as JSON, so that the same field names and custom unmarshalers are used for
+// both.
+func UnmarshalYAML(data []byte, v interface{}) error {
+	var doc interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,28 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalYAML(t *testing.T) {
+	type pet struct {
+		Name  string   `json:"name"`
+		Kind  string   `json:"kind"`
+		Tags  []string `json:"tags,omitempty"`
+		Owner *string  `json:"owner"`
+	}
+
+	data, err := MarshalYAML(pet{Name: "Rex", Kind: "true", Tags: []string{"good boy", "12"}})
+	require.NoError(t, err)
+	assert.Equal(t, "name: Rex\nkind: \"true\"\ntags:\n    - good boy\n    - \"12\"\nowner: null\n", string(data))
+
+	data, err = MarshalYAML([]int{1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, "- 1\n- 2\n", string(data))
+
+	_, err = MarshalYAML(make(chan int))
+	assert.Error(t, err)
+}
@@ -0,0 +1,81 @@
+// Package specui serves an API explorer for an OpenAPI specification. The
+// explorer's assets are embedded, so the page works without network access.
+package specui
+
+import (
+	"embed"
+	"html/template"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+//go:embed dist/*.js dist/*.css dist/*.png dist/LICENSE dist/NOTICE
+var dist embed.FS
+
+var assets, _ = fs.Sub(dist, "dist")
+
+var swaggerUIPage = template.Must(template.New("swagger-ui").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+	<meta charset="UTF-8">
+	<title>Swagger UI</title>
+	<link rel="stylesheet" type="text/css" href="./swagger-ui.css">
+	<link rel="icon" type="image/png" href="./favicon-32x32.png" sizes="32x32">
+	<link rel="icon" type="image/png" href="./favicon-16x16.png" sizes="16x16">
+	<style>body { margin: 0; }</style>
+</head>
+<body>
+	<div id="swagger-ui"></div>
+	<script src="./swagger-ui-bundle.js" charset="UTF-8"></script>
+	<script>
+	window.onload = function() {
+		window.ui = SwaggerUIBundle({
+			url: {{.}},
+			dom_id: "#swagger-ui",
+			deepLinking: true,
+			presets: [SwaggerUIBundle.presets.apis],
+			layout: "BaseLayout"
+		});
+	};
+	</script>
+</body>
+</html>
+`))
+
+// SwaggerUI returns an http.Handler serving a Swagger UI page, which displays
+// the specification at specURL.
+//
+// The handler may be mounted at any path. It serves the page for any request
+// path ending in a slash, and the page's assets relative to it. Other paths
+// are redirected to the same path with a trailing slash.
+func SwaggerUI(specURL string) http.Handler {
+	files := http.FileServer(http.FS(assets))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := path.Base(r.URL.Path)
+		if info, err := fs.Stat(assets, name); err == nil && !info.IsDir() && !strings.HasSuffix(r.URL.Path, "/") {
+			r2 := r.Clone(r.Context())
+			r2.URL.Path = "/" + name
+			files.ServeHTTP(w, r2)
+			return
+		}
+
+		if !strings.HasSuffix(r.URL.Path, "/") {
+			// The request may have been routed with a stripped prefix, so
+			// redirect to the path the client requested.
+			requested := r.URL.Path
+			if u, err := url.ParseRequestURI(r.RequestURI); err == nil {
+				requested = u.Path
+			}
+			http.Redirect(w, r, requested+"/", http.StatusMovedPermanently)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if err := swaggerUIPage.Execute(w, specURL); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,47 @@
+package specui
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSwaggerUI(t *testing.T) {
+	h := http.StripPrefix("/docs", SwaggerUI("/api/openapi.json"))
+
+	get := func(url string) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, url, nil))
+		return rr
+	}
+
+	t.Run("page", func(t *testing.T) {
+		rr := get("/docs/")
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "text/html; charset=utf-8", rr.Header().Get("Content-Type"))
+		assert.Contains(t, rr.Body.String(), `url: "/api/openapi.json"`)
+		assert.Contains(t, rr.Body.String(), `src="./swagger-ui-bundle.js"`)
+	})
+
+	t.Run("assets", func(t *testing.T) {
+		rr := get("/docs/swagger-ui-bundle.js")
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Header().Get("Content-Type"), "javascript")
+
+		rr = get("/docs/swagger-ui.css")
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Header().Get("Content-Type"), "text/css")
+
+		rr = get("/docs/NOTICE")
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "SmartBear")
+	})
+
+	t.Run("redirect", func(t *testing.T) {
+		rr := get("/docs")
+		assert.Equal(t, http.StatusMovedPermanently, rr.Code)
+		assert.Equal(t, "/docs/", rr.Header().Get("Location"))
+	})
+}
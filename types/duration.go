@@ -0,0 +1,199 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Duration represents a string that must conform to an ISO 8601 duration,
+// such as P1DT12H or PT0.5S.
+//
+// Days and weeks are 24 hours and 7 days long. Years and months, which don't
+// have a fixed length, are not supported.
+//
+// Duration implements the JSON.Marshaler, JSON.Unmarshaler,
+// encoding.TextMarshaler and encoding.TextUnmarshaler interfaces, and
+// validates that the duration matches the ISO 8601 format.
+type Duration struct {
+	time.Duration
+}
+
+// durationUnits are the designators of ISO 8601 durations, in order.
+var durationUnits = []struct {
+	designator byte
+	time       bool
+	unit       time.Duration
+}{
+	{'Y', false, 0},
+	{'M', false, 0},
+	{'W', false, 7 * 24 * time.Hour},
+	{'D', false, 24 * time.Hour},
+	{'H', true, time.Hour},
+	{'M', true, time.Minute},
+	{'S', true, time.Second},
+}
+
+// ParseDuration parses s as an ISO 8601 duration, optionally preceded by a
+// sign.
+func ParseDuration(s string) (Duration, error) {
+	invalid := fmt.Errorf("duration: invalid format: %q", s)
+
+	rest := s
+	neg := false
+	if rest != "" && (rest[0] == '-' || rest[0] == '+') {
+		neg = rest[0] == '-'
+		rest = rest[1:]
+	}
+	if rest == "" || rest[0] != 'P' {
+		return Duration{}, invalid
+	}
+	rest = rest[1:]
+
+	var d time.Duration
+	inTime, components, next := false, 0, 0
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime || len(rest) == 1 {
+				return Duration{}, invalid
+			}
+			inTime = true
+			rest = rest[1:]
+			continue
+		}
+
+		i := strings.IndexFunc(rest, func(r rune) bool {
+			return (r < '0' || r > '9') && r != '.' && r != ','
+		})
+		if i <= 0 {
+			return Duration{}, invalid
+		}
+		number, designator := rest[:i], rest[i]
+		rest = rest[i+1:]
+
+		unit := -1
+		for j := next; j < len(durationUnits); j++ {
+			if durationUnits[j].designator == designator && durationUnits[j].time == inTime {
+				unit = j
+				break
+			}
+		}
+		if unit < 0 {
+			return Duration{}, invalid
+		}
+		if durationUnits[unit].unit == 0 {
+			return Duration{}, fmt.Errorf("duration: years and months are not supported: %q", s)
+		}
+		next = unit + 1
+
+		v, err := parseDurationComponent(number, durationUnits[unit].unit)
+		if err != nil || v > math.MaxInt64-d {
+			return Duration{}, invalid
+		}
+		d += v
+		components++
+	}
+	if components == 0 {
+		return Duration{}, invalid
+	}
+
+	if neg {
+		d = -d
+	}
+	return Duration{d}, nil
+}
+
+// parseDurationComponent parses a decimal number of units, with an optional
+// fraction.
+func parseDurationComponent(s string, unit time.Duration) (time.Duration, error) {
+	whole, frac := s, ""
+	if i := strings.IndexAny(s, ".,"); i >= 0 {
+		whole, frac = s[:i], s[i+1:]
+		if frac == "" || strings.ContainsAny(frac, ".,") {
+			return 0, errors.New("invalid fraction")
+		}
+	}
+
+	n, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || n > math.MaxInt64/int64(unit) {
+		return 0, errors.New("invalid number")
+	}
+	d := time.Duration(n) * unit
+
+	scale := unit
+	for _, c := range frac {
+		scale /= 10
+		d += time.Duration(c-'0') * scale
+	}
+	return d, nil
+}
+
+// String returns d as an ISO 8601 duration in hours, minutes and seconds, such
+// as PT36H or -PT1M0.5S.
+func (d Duration) String() string {
+	var b strings.Builder
+
+	u := uint64(d.Duration)
+	if d.Duration < 0 {
+		b.WriteByte('-')
+		u = -u
+	}
+	b.WriteString("PT")
+	if u == 0 {
+		b.WriteString("0S")
+		return b.String()
+	}
+
+	hours := u / uint64(time.Hour)
+	u -= hours * uint64(time.Hour)
+	minutes := u / uint64(time.Minute)
+	u -= minutes * uint64(time.Minute)
+	if hours > 0 {
+		b.WriteString(strconv.FormatUint(hours, 10) + "H")
+	}
+	if minutes > 0 {
+		b.WriteString(strconv.FormatUint(minutes, 10) + "M")
+	}
+	if u > 0 {
+		seconds := strconv.FormatUint(u/uint64(time.Second), 10)
+		if nanos := u % uint64(time.Second); nanos > 0 {
+			frac := strconv.FormatUint(nanos+uint64(time.Second), 10)[1:]
+			seconds += "." + strings.TrimRight(frac, "0")
+		}
+		b.WriteString(seconds + "S")
+	}
+	return b.String()
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (d *Duration) UnmarshalText(data []byte) error {
+	parsed, err := ParseDuration(string(data))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return d.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in  string
+		out time.Duration
+	}{
+		{"PT0S", 0},
+		{"PT1H30M", 90 * time.Minute},
+		{"P1DT12H", 36 * time.Hour},
+		{"P2W", 14 * 24 * time.Hour},
+		{"PT0.5S", 500 * time.Millisecond},
+		{"PT1,25M", 75 * time.Second},
+		{"-PT1M0.000000001S", -(time.Minute + time.Nanosecond)},
+		{"+P1D", 24 * time.Hour},
+	}
+	for _, tt := range tests {
+		d, err := ParseDuration(tt.in)
+		if assert.NoError(t, err, tt.in) {
+			assert.Equal(t, tt.out, d.Duration, tt.in)
+		}
+	}
+
+	for _, invalid := range []string{
+		"", "P", "PT", "1H", "P1H", "PT1D", "PT1S1M", "P1DT", "PT.5S", "PT1.S", "PT1.2.3S",
+		"P9999999999999999999D",
+	} {
+		_, err := ParseDuration(invalid)
+		assert.Error(t, err, invalid)
+	}
+
+	_, err := ParseDuration("P1Y2M")
+	assert.EqualError(t, err, `duration: years and months are not supported: "P1Y2M"`)
+}
+
+func TestDuration_String(t *testing.T) {
+	assert.Equal(t, "PT0S", Duration{}.String())
+	assert.Equal(t, "PT36H", Duration{36 * time.Hour}.String())
+	assert.Equal(t, "PT1H0.25S", Duration{time.Hour + 250*time.Millisecond}.String())
+	assert.Equal(t, "-PT2M3S", Duration{-(2*time.Minute + 3*time.Second)}.String())
+}
+
+func TestDuration_MarshalJSON(t *testing.T) {
+	b := struct {
+		DurationField Duration `json:"duration"`
+	}{
+		DurationField: Duration{90 * time.Minute},
+	}
+	jsonBytes, err := json.Marshal(b)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"duration":"PT1H30M"}`, string(jsonBytes))
+}
+
+func TestDuration_UnmarshalJSON(t *testing.T) {
+	b := struct {
+		DurationField Duration `json:"duration"`
+	}{}
+	err := json.Unmarshal([]byte(`{"duration":"P1DT1.5S"}`), &b)
+	require.NoError(t, err)
+	assert.Equal(t, 24*time.Hour+1500*time.Millisecond, b.DurationField.Duration)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"duration":"1h"}`), &b))
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+)
+
+// File represents binary content, such as an uploaded file.
+//
+// File implements the JSON.Marshaler and JSON.Unmarshaler interfaces, with
+// the content encoded as base64, and the encoding.TextMarshaler and
+// encoding.TextUnmarshaler interfaces, with the content as is.
+type File struct {
+	multipart *multipart.FileHeader
+	data      []byte
+	filename  string
+}
+
+// InitFromMultipart sets the content of f to the file of a multipart form.
+func (f *File) InitFromMultipart(header *multipart.FileHeader) {
+	f.multipart = header
+	f.data = nil
+	f.filename = header.Filename
+}
+
+// InitFromBytes sets the content of f to data.
+func (f *File) InitFromBytes(data []byte, filename string) {
+	f.multipart = nil
+	f.data = data
+	f.filename = filename
+}
+
+// Filename returns the name of the file, if any.
+func (f File) Filename() string {
+	return f.filename
+}
+
+// FileSize returns the size of the content in bytes.
+func (f File) FileSize() int64 {
+	if f.multipart != nil {
+		return f.multipart.Size
+	}
+	return int64(len(f.data))
+}
+
+// Reader returns a reader of the content.
+func (f File) Reader() (io.ReadCloser, error) {
+	if f.multipart != nil {
+		return f.multipart.Open()
+	}
+	return io.NopCloser(bytes.NewReader(f.data)), nil
+}
+
+// Bytes reads the content.
+func (f File) Bytes() ([]byte, error) {
+	if f.multipart == nil {
+		return f.data, nil
+	}
+
+	r, err := f.multipart.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f File) MarshalText() ([]byte, error) {
+	return f.Bytes()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (f *File) UnmarshalText(data []byte) error {
+	f.InitFromBytes(append([]byte(nil), data...), "")
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (f File) MarshalJSON() ([]byte, error) {
+	data, err := f.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (f *File) UnmarshalJSON(data []byte) error {
+	var content []byte
+	if err := json.Unmarshal(data, &content); err != nil {
+		return err
+	}
+	f.InitFromBytes(content, "")
+	return nil
+}
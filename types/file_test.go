@@ -0,0 +1,59 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile_JSON(t *testing.T) {
+	var f File
+	f.InitFromBytes([]byte("hello"), "hello.txt")
+
+	jsonBytes, err := json.Marshal(f)
+	require.NoError(t, err)
+	assert.Equal(t, `"aGVsbG8="`, string(jsonBytes))
+
+	var decoded File
+	require.NoError(t, json.Unmarshal(jsonBytes, &decoded))
+	data, err := decoded.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(data))
+	assert.Equal(t, int64(5), decoded.FileSize())
+}
+
+func TestFile_InitFromMultipart(t *testing.T) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile("file", "pet.png")
+	require.NoError(t, err)
+	_, err = part.Write([]byte("png"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	r := httptest.NewRequest("POST", "/", &body)
+	r.Header.Set("Content-Type", w.FormDataContentType())
+	require.NoError(t, r.ParseMultipartForm(1<<20))
+
+	var f File
+	f.InitFromMultipart(r.MultipartForm.File["file"][0])
+	assert.Equal(t, "pet.png", f.Filename())
+	assert.Equal(t, int64(3), f.FileSize())
+
+	rc, err := f.Reader()
+	require.NoError(t, err)
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "png", string(data))
+
+	text, err := f.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "png", string(text))
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+)
+
+// IP represents a string that must conform to an IPv4 or IPv6 address.
+//
+// IP implements the JSON.Marshaler, JSON.Unmarshaler,
+// encoding.TextMarshaler and encoding.TextUnmarshaler interfaces, and
+// validates that unmarshaled addresses are valid IPs. The zero IP is
+// marshaled as an empty string, or null in JSON.
+type IP struct {
+	net.IP
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (ip IP) MarshalText() ([]byte, error) {
+	if len(ip.IP) == 0 {
+		return []byte(""), nil
+	}
+	return []byte(ip.IP.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (ip *IP) UnmarshalText(data []byte) error {
+	parsed := net.ParseIP(string(data))
+	if parsed == nil {
+		return fmt.Errorf("ip: invalid address %q", data)
+	}
+	ip.IP = parsed
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (ip IP) MarshalJSON() ([]byte, error) {
+	if len(ip.IP) == 0 {
+		return []byte("null"), nil
+	}
+	return json.Marshal(ip.IP.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (ip *IP) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return ip.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIP_MarshalJSON(t *testing.T) {
+	b := struct {
+		IPv4 IP `json:"ipv4"`
+		IPv6 IP `json:"ipv6"`
+	}{
+		IPv4: IP{net.IPv4(192, 168, 0, 1)},
+		IPv6: IP{net.IPv6loopback},
+	}
+	jsonBytes, err := json.Marshal(b)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"ipv4":"192.168.0.1","ipv6":"::1"}`, string(jsonBytes))
+
+	jsonBytes, err = json.Marshal(IP{})
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(jsonBytes))
+
+	text, err := IP{}.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "", string(text))
+}
+
+func TestIP_UnmarshalJSON(t *testing.T) {
+	b := struct {
+		IPField IP `json:"ip"`
+	}{}
+	err := json.Unmarshal([]byte(`{"ip":"2001:db8::68"}`), &b)
+	require.NoError(t, err)
+	assert.True(t, b.IPField.Equal(net.ParseIP("2001:db8::68")))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"ip":"192.168.0"}`), &b))
+
+	var zero IP
+	require.NoError(t, json.Unmarshal([]byte("null"), &zero))
+	assert.Nil(t, zero.IP)
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// URI represents a string that must conform to an absolute URI, such as
+// https://example.com/pets?limit=10.
+//
+// URI implements the JSON.Marshaler, JSON.Unmarshaler,
+// encoding.TextMarshaler and encoding.TextUnmarshaler interfaces, and
+// validates that unmarshaled URIs are absolute.
+type URI string
+
+// URL parses the URI.
+func (u URI) URL() (*url.URL, error) {
+	return parseURI(string(u))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (u URI) MarshalText() ([]byte, error) {
+	return []byte(u), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (u *URI) UnmarshalText(data []byte) error {
+	if _, err := parseURI(string(data)); err != nil {
+		return err
+	}
+	*u = URI(data)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (u URI) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(u))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (u *URI) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return u.UnmarshalText([]byte(s))
+}
+
+func parseURI(s string) (*url.URL, error) {
+	parsed, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("uri: %w", err)
+	}
+	if !parsed.IsAbs() {
+		return nil, errors.New("uri: not an absolute URI")
+	}
+	return parsed, nil
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestURI_MarshalJSON(t *testing.T) {
+	b := struct {
+		URIField URI `json:"uri"`
+	}{
+		URIField: URI("https://example.com/pets?limit=10"),
+	}
+	jsonBytes, err := json.Marshal(b)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"uri":"https://example.com/pets?limit=10"}`, string(jsonBytes))
+
+	b.URIField = ""
+	jsonBytes, err = json.Marshal(b)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"uri":""}`, string(jsonBytes))
+
+	text, err := URI("").MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "", string(text))
+}
+
+func TestURI_UnmarshalJSON(t *testing.T) {
+	b := struct {
+		URIField URI `json:"uri"`
+	}{}
+	err := json.Unmarshal([]byte(`{"uri":"urn:isbn:0451450523"}`), &b)
+	require.NoError(t, err)
+	assert.Equal(t, URI("urn:isbn:0451450523"), b.URIField)
+
+	u, err := b.URIField.URL()
+	require.NoError(t, err)
+	assert.Equal(t, "urn", u.Scheme)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"uri":"pets/1"}`), &b))
+	assert.Error(t, json.Unmarshal([]byte(`{"uri":"http://[::1"}`), &b))
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// UUID represents a string that must conform to the UUID format, eg
+// f81d4fae-7dec-11d0-a765-00a0c91e6bf6.
+//
+// UUID implements the JSON.Marshaler, JSON.Unmarshaler,
+// encoding.TextMarshaler and encoding.TextUnmarshaler interfaces, and
+// validates that the UUID matches the UUID format.
+type UUID [16]byte
+
+// ParseUUID parses s in the canonical UUID format, in any case.
+func ParseUUID(s string) (UUID, error) {
+	var u UUID
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return u, fmt.Errorf("uuid: invalid format: %q", s)
+	}
+
+	src := []byte(s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:])
+	if _, err := hex.Decode(u[:], src); err != nil {
+		return UUID{}, fmt.Errorf("uuid: invalid format: %q", s)
+	}
+	return u, nil
+}
+
+// String returns u in the canonical lowercase format.
+func (u UUID) String() string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	return string(buf[:])
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (u *UUID) UnmarshalText(data []byte) error {
+	parsed, err := ParseUUID(string(data))
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (u UUID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(u.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (u *UUID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return u.UnmarshalText([]byte(s))
+}
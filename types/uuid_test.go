@@ -0,0 +1,40 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUUID_MarshalJSON(t *testing.T) {
+	u := UUID{0xf8, 0x1d, 0x4f, 0xae, 0x7d, 0xec, 0x11, 0xd0, 0xa7, 0x65, 0x00, 0xa0, 0xc9, 0x1e, 0x6b, 0xf6}
+	b := struct {
+		UUIDField UUID `json:"uuid"`
+	}{
+		UUIDField: u,
+	}
+	jsonBytes, err := json.Marshal(b)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"uuid":"f81d4fae-7dec-11d0-a765-00a0c91e6bf6"}`, string(jsonBytes))
+}
+
+func TestUUID_UnmarshalJSON(t *testing.T) {
+	b := struct {
+		UUIDField UUID `json:"uuid"`
+	}{}
+	err := json.Unmarshal([]byte(`{"uuid":"F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6"}`), &b)
+	require.NoError(t, err)
+	assert.Equal(t, "f81d4fae-7dec-11d0-a765-00a0c91e6bf6", b.UUIDField.String())
+
+	for _, invalid := range []string{
+		`"f81d4fae7dec11d0a76500a0c91e6bf6"`,
+		`"f81d4fae-7dec-11d0-a765-00a0c91e6bfx"`,
+		`"f81d4fae-7dec-11d0-a765_00a0c91e6bf6"`,
+		`42`,
+	} {
+		var u UUID
+		assert.Error(t, json.Unmarshal([]byte(invalid), &u), invalid)
+	}
+}
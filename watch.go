@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+func watch(c *cli.Context, cfg *config) error {
+	if len(cfg.Jobs) != 0 {
+		return errors.New("watching a configuration listing jobs is not supported")
+	}
+
+	file := c.Args().First()
+	if file == "" {
+		file = cfg.Spec
+	}
+	if file == "" {
+		return errors.New("a spec file is required")
+	}
+	if cfg.Out == "" {
+		return errors.New("an output file is required")
+	}
+	if cfg.Package == "" {
+		cfg.Package = defaultPackage(file)
+	}
+
+	ctx, stop := signal.NotifyContext(c.Context, os.Interrupt)
+	defer stop()
+
+	w := watcher{cfg: cfg, file: file}
+	w.watch(ctx, c.Duration(IntervalKey))
+	return nil
+}
+
+// fileState is what is compared to detect changes to a watched file. A file
+// which doesn't exist has the zero state.
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// watcher regenerates code for a spec, and keeps track of the files which the
+// generated code depends on.
+type watcher struct {
+	cfg  *config
+	file string
+
+	// refs are the files of external references read by the last successful
+	// generation.
+	refs  []string
+	state map[string]fileState
+}
+
+// watch generates code, and regenerates it whenever a watched file changes,
+// checking every interval until ctx is done.
+func (w *watcher) watch(ctx context.Context, interval time.Duration) {
+	w.regenerate()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if w.changed() {
+				w.regenerate()
+			}
+		}
+	}
+}
+
+// paths returns the files which are watched: the spec, its external
+// references, and the templates directory along with the templates in it.
+func (w *watcher) paths() []string {
+	paths := append([]string{w.file}, w.refs...)
+	if w.cfg.Templates == "" {
+		return paths
+	}
+
+	// The directory itself changes when templates are added or removed.
+	paths = append(paths, w.cfg.Templates)
+	entries, err := os.ReadDir(w.cfg.Templates)
+	if err != nil {
+		return paths
+	}
+	for _, e := range entries {
+		paths = append(paths, filepath.Join(w.cfg.Templates, e.Name()))
+	}
+	return paths
+}
+
+func (w *watcher) snapshot() map[string]fileState {
+	state := make(map[string]fileState)
+	for _, path := range w.paths() {
+		var s fileState
+		if fi, err := os.Stat(path); err == nil {
+			s = fileState{modTime: fi.ModTime(), size: fi.Size()}
+		}
+		state[path] = s
+	}
+	return state
+}
+
+// changed reports whether any watched file changed since the last generation.
+func (w *watcher) changed() bool {
+	state := w.snapshot()
+	if len(state) != len(w.state) {
+		return true
+	}
+	for path, s := range state {
+		if prev, ok := w.state[path]; !ok || !prev.modTime.Equal(s.modTime) || prev.size != s.size {
+			return true
+		}
+	}
+	return false
+}
+
+// regenerate generates code, and writes it to the output file if it changed.
+// Errors are reported, but don't stop the watcher, so that the spec can be
+// fixed and picked up again.
+func (w *watcher) regenerate() {
+	defer func() { w.state = w.snapshot() }()
+
+	code, refs, err := generate(w.cfg, w.file)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		return
+	}
+	w.refs = refs
+
+	written, err := writeIfChanged(w.cfg.Out, code)
+	switch {
+	case err != nil:
+		fmt.Fprintf(os.Stderr, "error: could not write code: %v\n", err)
+	case written:
+		fmt.Fprintf(os.Stderr, "wrote %s\n", w.cfg.Out)
+	default:
+		fmt.Fprintf(os.Stderr, "%s is up to date\n", w.cfg.Out)
+	}
+}
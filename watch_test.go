@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const watchedSpec = `openapi: "3.0.1"
+info:
+  title: Watched
+  version: 1.0.0
+paths:
+  /things:
+    $ref: "./things.yaml#/paths/~1things"
+`
+
+const watchedPath = `paths:
+  /things:
+    get:
+      operationId: %s
+      responses:
+        204:
+          description: Things
+`
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+func TestWatch(t *testing.T) {
+	dir := t.TempDir()
+	spec := filepath.Join(dir, "spec.yaml")
+	ref := filepath.Join(dir, "things.yaml")
+	out := filepath.Join(dir, "spec.gen.go")
+	writeFile(t, spec, watchedSpec)
+	writeFile(t, ref, fmt.Sprintf(watchedPath, "getThings"))
+
+	w := watcher{
+		cfg:  &config{Package: "watched", Generate: []string{"types", "server"}, Out: out},
+		file: spec,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		w.watch(ctx, 10*time.Millisecond)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	generated := func(s string) func() bool {
+		return func() bool {
+			code, err := os.ReadFile(out)
+			return err == nil && strings.Contains(string(code), s)
+		}
+	}
+	require.Eventually(t, generated("GetThings(w"), 5*time.Second, 10*time.Millisecond)
+
+	// Referenced files are watched.
+	writeFile(t, ref, fmt.Sprintf(watchedPath, "listThings"))
+	require.Eventually(t, generated("ListThings(w"), 5*time.Second, 10*time.Millisecond)
+
+	// The watcher keeps going after a generation error, and picks up the
+	// fixed spec.
+	writeFile(t, spec, "openapi: [")
+	time.Sleep(50 * time.Millisecond)
+	assert.True(t, generated("ListThings(w")())
+	writeFile(t, spec, watchedSpec+"  /others:\n    $ref: \"./things.yaml#/paths/~1others\"\n")
+	writeFile(t, ref, fmt.Sprintf(watchedPath, "listThings")+"  /others:\n    get:\n      operationId: listOthers\n      responses:\n        204:\n          description: Others\n")
+	require.Eventually(t, generated("ListOthers(w"), 5*time.Second, 10*time.Millisecond)
+}
+
+func TestWriteIfChanged(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.go")
+
+	written, err := writeIfChanged(path, "package out\n")
+	require.NoError(t, err)
+	assert.True(t, written)
+
+	written, err = writeIfChanged(path, "package out\n")
+	require.NoError(t, err)
+	assert.False(t, written)
+
+	written, err = writeIfChanged(path, "package out // changed\n")
+	require.NoError(t, err)
+	assert.True(t, written)
+}